@@ -2,16 +2,11 @@ package main
 
 import (
 	"fmt"
-	"math/rand"
-	"time"
 
 	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
 )
 
 func main() {
-	// Seed the random number generator
-	rand.Seed(time.Now().UnixNano())
-
 	fmt.Println("=== Texas Hold'em Hand Evaluator Demo ===")
 	fmt.Println()
 
@@ -19,26 +14,24 @@ func main() {
 	deck := poker.NewDeck()
 	fmt.Printf("Created new deck with %d cards\n\n", len(deck.Cards))
 
-	// Shuffle the deck
-	shuffleDeck(deck)
+	// Shuffle the deck using crypto/rand
+	deck.Shuffle(poker.CryptoSource{})
 	fmt.Println("Deck shuffled")
 	fmt.Println()
 
-	// Deal cards for Player 1
-	player1Cards, err := deck.Deal(2)
-	if err != nil {
-		fmt.Printf("Error dealing cards: %v\n", err)
-		return
-	}
-	fmt.Printf("Player 1 hole cards: %s %s\n", player1Cards[0], player1Cards[1])
-
-	// Deal cards for Player 2
-	player2Cards, err := deck.Deal(2)
-	if err != nil {
-		fmt.Printf("Error dealing cards: %v\n", err)
-		return
+	// Deal hole cards to each player
+	const numPlayers = 4
+	players := make([]poker.ShowdownPlayer, numPlayers)
+	for i := range players {
+		hole, err := deck.Deal(2)
+		if err != nil {
+			fmt.Printf("Error dealing cards: %v\n", err)
+			return
+		}
+		players[i].Hole = hole
+		fmt.Printf("Player %d hole cards: %s %s\n", i+1, hole[0], hole[1])
 	}
-	fmt.Printf("Player 2 hole cards: %s %s\n\n", player2Cards[0], player2Cards[1])
+	fmt.Println()
 
 	// Deal community cards (flop, turn, river)
 	communityCards, err := deck.Deal(5)
@@ -50,54 +43,31 @@ func main() {
 		communityCards[0], communityCards[1], communityCards[2],
 		communityCards[3], communityCards[4])
 
-	// Combine hole cards + community cards for each player
-	player1Hand := make([]poker.Card, 0, 7)
-	player1Hand = append(player1Hand, player1Cards...)
-	player1Hand = append(player1Hand, communityCards...)
-
-	player2Hand := make([]poker.Card, 0, 7)
-	player2Hand = append(player2Hand, player2Cards...)
-	player2Hand = append(player2Hand, communityCards...)
-
-	fmt.Printf("Player 1's 7 cards: ")
-	for _, card := range player1Hand {
-		fmt.Printf("%s ", card)
+	// Evaluate every player's best hand with the board
+	fmt.Println("=== Hand Analysis ===")
+	result, err := poker.Showdown(communityCards, players...)
+	if err != nil {
+		fmt.Printf("Error at showdown: %v\n", err)
+		return
 	}
-	fmt.Println()
-
-	fmt.Printf("Player 2's 7 cards: ")
-	for _, card := range player2Hand {
-		fmt.Printf("%s ", card)
+	for i, hand := range result.Hands {
+		fmt.Printf("Player %d's best hand: %s\n", i+1, hand.Describe())
 	}
-	fmt.Println()
-	fmt.Println()
 
-	// Evaluate best hands using FindBestHand
-	fmt.Println("=== Hand Analysis ===")
-	best1 := poker.FindBestHand(player1Hand)
-	best2 := poker.FindBestHand(player2Hand)
-
-	fmt.Printf("Player 1's best hand: %s\n", best1.Category)
-	fmt.Printf("Player 2's best hand: %s\n", best2.Category)
-
-	// Compare hands and determine winner
-	result := poker.CompareHands(best1, best2)
+	// Award a 1000-chip pot, splitting it between tied winners
+	_, payouts, err := result.Award([]poker.Pot{{Amount: 1000}}, poker.OddChipLeftOfButton)
+	if err != nil {
+		fmt.Printf("Error awarding the pot: %v\n", err)
+		return
+	}
 	fmt.Println()
-	if result > 0 {
-		fmt.Println("🏆 Player 1 WINS!")
-	} else if result < 0 {
-		fmt.Println("🏆 Player 2 WINS!")
-	} else {
+	winners := result.Winners(nil)
+	if len(winners) > 1 {
 		fmt.Println("🤝 SPLIT POT - Hands are equal!")
 	}
+	for _, i := range winners {
+		fmt.Printf("🏆 Player %d wins %d chips\n", i+1, payouts[i])
+	}
 
 	fmt.Printf("\nRemaining cards in deck: %d\n", len(deck.Cards))
 }
-
-// shuffleDeck shuffles the deck using Fisher-Yates algorithm
-func shuffleDeck(d *poker.Deck) {
-	for i := len(d.Cards) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
-		d.Cards[i], d.Cards[j] = d.Cards[j], d.Cards[i]
-	}
-}
@@ -0,0 +1,67 @@
+package game
+
+// ActionType is a kind of action in a betting round.
+type ActionType int
+
+const (
+	Fold  ActionType = iota // Give up the hand
+	Check                   // Pass with nothing to call
+	Call                    // Match the current bet
+	Bet                     // Open the betting on a street
+	Raise                   // Increase the current bet
+
+	// Forced bets are recorded in the action history but cannot be played
+	// with Hand.Act.
+	PostAnte
+	PostSmallBlind
+	PostBigBlind
+)
+
+// String returns the action name, such as "Raise".
+func (a ActionType) String() string {
+	switch a {
+	case Fold:
+		return "Fold"
+	case Check:
+		return "Check"
+	case Call:
+		return "Call"
+	case Bet:
+		return "Bet"
+	case Raise:
+		return "Raise"
+	case PostAnte:
+		return "Post Ante"
+	case PostSmallBlind:
+		return "Post Small Blind"
+	case PostBigBlind:
+		return "Post Big Blind"
+	default:
+		return "Unknown"
+	}
+}
+
+// Action is one player's action. When playing an action with Hand.Act,
+// only Seat, Type and, for a bet or raise, Amount are read; the engine
+// fills in the rest when recording it.
+type Action struct {
+	Seat int
+	Type ActionType
+
+	// Amount is the player's total bet on the street after a Bet or Raise
+	// (the "raise to" amount), or the chips put in by a Call or forced bet.
+	Amount int
+
+	Street Street // Street the action was taken on
+	AllIn  bool   // The action put the player all-in
+}
+
+// Legal describes the actions open to the player to act.
+type Legal struct {
+	Seat    int
+	Actions []ActionType
+
+	Call  int // Chips needed to call; less than the bet if calling puts the player all-in
+	MinTo int // Smallest total street bet for a Bet or Raise
+	MaxTo int // Largest total street bet for a Bet or Raise
+}
@@ -0,0 +1,29 @@
+package game
+
+import "testing"
+
+// TestActionTypeString verifies the name of every action type.
+func TestActionTypeString(t *testing.T) {
+	tests := []struct {
+		action ActionType
+		want   string
+	}{
+		{Fold, "Fold"},
+		{Check, "Check"},
+		{Call, "Call"},
+		{Bet, "Bet"},
+		{Raise, "Raise"},
+		{PostAnte, "Post Ante"},
+		{PostSmallBlind, "Post Small Blind"},
+		{PostBigBlind, "Post Big Blind"},
+		{ActionType(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.action.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
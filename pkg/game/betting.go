@@ -0,0 +1,105 @@
+package game
+
+import "math"
+
+// BetState is the betting situation facing the player to act, as an
+// engine reports it to a BettingStructure.
+type BetState struct {
+	Round      int // Betting round, from 0 (preflop in hold'em, third street in stud)
+	Bet        int // Chips the player has put in this round
+	CurrentBet int // Largest total bet this round; 0 if nobody has bet
+	MinRaise   int // Smallest raise increment that counts as a full raise
+	Pot        int // Every chip put in so far, current round bets included
+	BigBlind   int // Big blind, or the smallest bet in games without blinds
+}
+
+// BettingStructure decides how much a player may bet or raise. The engine
+// running the hand handles folding, checking and calling, the all-in cap
+// and whether the betting has been reopened; a structure only sets the
+// size of bets and raises, so one structure serves hold'em, Omaha and stud
+// engines alike.
+type BettingStructure interface {
+	// Name returns the structure's name, such as "No Limit".
+	Name() string
+
+	// RaiseLimits returns the smallest and largest total the player may
+	// bet or raise to this round, before capping at the player's stack.
+	// ok is false if the structure allows no further bet or raise.
+	RaiseLimits(s BetState) (minTo, maxTo int, ok bool)
+}
+
+// minRaiseTo returns the smallest full bet or raise: the big blind for an
+// opening bet, otherwise the current bet plus the last full raise.
+func minRaiseTo(s BetState) int {
+	if s.CurrentBet == 0 {
+		return s.BigBlind
+	}
+	return s.CurrentBet + max(s.MinRaise, s.BigBlind)
+}
+
+// NoLimit lets a player bet or raise any amount up to their whole stack.
+type NoLimit struct{}
+
+// Name returns "No Limit".
+func (NoLimit) Name() string { return "No Limit" }
+
+// RaiseLimits allows any total from the minimum full raise upward.
+func (NoLimit) RaiseLimits(s BetState) (minTo, maxTo int, ok bool) {
+	return minRaiseTo(s), math.MaxInt, true
+}
+
+// PotLimit caps every bet or raise at the size of the pot after calling.
+type PotLimit struct{}
+
+// Name returns "Pot Limit".
+func (PotLimit) Name() string { return "Pot Limit" }
+
+// RaiseLimits allows totals from the minimum full raise up to a pot-sized
+// raise: the player first calls, then raises by the whole pot including
+// that call. With blinds of 1 and 2, the first player preflop may raise to
+// 7 (call 2, making the pot 5, then raise 5).
+func (PotLimit) RaiseLimits(s BetState) (minTo, maxTo int, ok bool) {
+	toCall := s.CurrentBet - s.Bet
+	maxTo = s.CurrentBet + s.Pot + toCall
+	return min(minRaiseTo(s), maxTo), maxTo, true
+}
+
+// FixedLimit allows bets and raises of one fixed size per round: SmallBet
+// before BigBetRound, BigBet from then on. Cap limits the bets and raises
+// per round, counting a preflop big blind of SmallBet as the first bet;
+// 0 means no cap.
+type FixedLimit struct {
+	SmallBet    int
+	BigBet      int
+	BigBetRound int // First round using BigBet: 2 for the turn in hold'em, fifth street in stud
+	Cap         int
+}
+
+// NewFixedLimit returns a fixed-limit structure with the usual settings for
+// hold'em, Omaha and stud: big bets from the third betting round on, and a
+// cap of one bet and three raises per round.
+func NewFixedLimit(smallBet, bigBet int) FixedLimit {
+	return FixedLimit{SmallBet: smallBet, BigBet: bigBet, BigBetRound: 2, Cap: 4}
+}
+
+// Name returns "Fixed Limit".
+func (FixedLimit) Name() string { return "Fixed Limit" }
+
+// unit returns the bet size for a round.
+func (f FixedLimit) unit(round int) int {
+	if round >= f.BigBetRound {
+		return f.BigBet
+	}
+	return f.SmallBet
+}
+
+// RaiseLimits allows exactly one more bet of the round's size on top of the
+// current bet, until the round is capped.
+func (f FixedLimit) RaiseLimits(s BetState) (minTo, maxTo int, ok bool) {
+	unit := f.unit(s.Round)
+	if unit <= 0 || (f.Cap > 0 && s.CurrentBet >= f.Cap*unit) {
+		return 0, 0, false
+	}
+	to := s.CurrentBet + unit
+	return to, to, true
+}
@@ -0,0 +1,61 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRaiseLimits verifies bet and raise bounds for each structure.
+func TestRaiseLimits(t *testing.T) {
+	fl := NewFixedLimit(20, 40)
+	tests := []struct {
+		name      string
+		structure BettingStructure
+		state     BetState
+		minTo     int
+		maxTo     int
+		ok        bool
+	}{
+		{"no limit opening bet", NoLimit{}, BetState{Round: 1, Pot: 60, BigBlind: 20}, 20, math.MaxInt, true},
+		{"no limit raise", NoLimit{}, BetState{CurrentBet: 70, MinRaise: 50, Pot: 100, BigBlind: 20}, 120, math.MaxInt, true},
+		{"pot limit first raise preflop", PotLimit{}, BetState{CurrentBet: 2, MinRaise: 2, Pot: 3, BigBlind: 2}, 4, 7, true},
+		{"pot limit opening bet", PotLimit{}, BetState{Round: 1, Pot: 60, BigBlind: 20}, 20, 60, true},
+		{"pot limit reraise", PotLimit{}, BetState{Bet: 20, CurrentBet: 80, MinRaise: 60, Pot: 130, BigBlind: 20}, 140, 270, true},
+		{"pot limit small pot", PotLimit{}, BetState{Round: 1, Pot: 10, BigBlind: 20}, 10, 10, true},
+		{"fixed limit small bet", fl, BetState{Round: 1, Pot: 60, BigBlind: 20}, 20, 20, true},
+		{"fixed limit raise over big blind", fl, BetState{CurrentBet: 20, Pot: 30, BigBlind: 20}, 40, 40, true},
+		{"fixed limit big bet", fl, BetState{Round: 2, CurrentBet: 40, Pot: 200, BigBlind: 20}, 80, 80, true},
+		{"fixed limit capped", fl, BetState{Round: 3, CurrentBet: 160, Pot: 600, BigBlind: 20}, 0, 0, false},
+		{"fixed limit uncapped", FixedLimit{SmallBet: 20, BigBet: 40, BigBetRound: 2}, BetState{CurrentBet: 200, BigBlind: 20}, 220, 220, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minTo, maxTo, ok := tt.structure.RaiseLimits(tt.state)
+			if minTo != tt.minTo || maxTo != tt.maxTo || ok != tt.ok {
+				t.Errorf("RaiseLimits() = %d, %d, %v, want %d, %d, %v",
+					minTo, maxTo, ok, tt.minTo, tt.maxTo, tt.ok)
+			}
+		})
+	}
+}
+
+// TestBettingStructureName verifies structure names.
+func TestBettingStructureName(t *testing.T) {
+	tests := []struct {
+		structure BettingStructure
+		want      string
+	}{
+		{NoLimit{}, "No Limit"},
+		{PotLimit{}, "Pot Limit"},
+		{NewFixedLimit(2, 4), "Fixed Limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.structure.Name(); got != tt.want {
+				t.Errorf("Name() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+// Package game runs complete poker hands on top of package poker: forced
+// bets, dealing, betting rounds with action validation, and the showdown.
+package game
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// Street is a betting round of a hold'em hand.
+type Street int
+
+const (
+	Preflop  Street = iota // Hole cards only
+	Flop                   // Three board cards
+	Turn                   // Fourth board card
+	River                  // Fifth board card
+	Complete               // The hand is over and the pots are awarded
+)
+
+// String returns the street name, such as "Flop".
+func (s Street) String() string {
+	switch s {
+	case Preflop:
+		return "Preflop"
+	case Flop:
+		return "Flop"
+	case Turn:
+		return "Turn"
+	case River:
+		return "River"
+	case Complete:
+		return "Complete"
+	default:
+		return "Unknown"
+	}
+}
+
+// Config holds the forced bets, the button position and the betting
+// structure for a hand.
+type Config struct {
+	SmallBlind int
+	BigBlind   int
+	Ante       int              // Posted by every player before the blinds; 0 for none
+	Button     int              // Seat of the dealer button
+	Betting    BettingStructure // Sizes of bets and raises; nil for NoLimit
+}
+
+// Player is one seat in a hand.
+type Player struct {
+	Stack       int          // Chips behind, not counting chips already put in
+	Hole        []poker.Card // Private cards
+	Bet         int          // Chips put in on the current street
+	Contributed int          // Chips put in this hand, antes and blinds included
+	Folded      bool
+	AllIn       bool
+
+	Hand *poker.Hand // Best hand at showdown; nil if the hand ended without one
+	Won  int         // Chips won from the pots, not counting uncalled bets returned
+
+	acted      bool // Has acted since the last bet or raise
+	raisesSeen int  // Hand.fullRaises when the player last acted this street; -1 before acting
+}
+
+// Hand is a hand of Texas Hold'em. Seats are numbered from 0
+// clockwise. The hand is deterministic given the order of the deck: hole
+// cards are dealt one at a time starting left of the button, then the
+// board, without burn cards.
+type Hand struct {
+	Config  Config
+	Deck    *poker.Deck
+	Players []Player
+	Board   []poker.Card
+	Street  Street
+
+	ToAct      int // Seat to act next, or -1 once the hand is complete
+	CurrentBet int // Largest total bet on the current street
+	MinRaise   int // Smallest legal raise increment on the current street
+
+	Actions    []Action          // Every action so far, forced bets included
+	Settlement *poker.Settlement // How the pots were awarded, in seat order; nil until Complete
+
+	fullRaises int // Full bets and raises on the current street
+}
+
+// NewHand starts a hand with the given stacks, posts antes and blinds, and
+// deals the hole cards from deck, which should already be shuffled. With
+// two players the button posts the small blind and acts first preflop.
+// Returns an error if fewer than 2 players are seated, a stack is not
+// positive, the forced bets or button are invalid, or the deck cannot
+// supply every hole card and the board.
+func NewHand(deck *poker.Deck, stacks []int, cfg Config) (*Hand, error) {
+	n := len(stacks)
+	switch {
+	case deck == nil:
+		return nil, fmt.Errorf("deck is nil")
+	case n < 2:
+		return nil, fmt.Errorf("need at least 2 players, got %d", n)
+	case cfg.SmallBlind <= 0 || cfg.BigBlind < cfg.SmallBlind:
+		return nil, fmt.Errorf("invalid blinds %d/%d", cfg.SmallBlind, cfg.BigBlind)
+	case cfg.Ante < 0:
+		return nil, fmt.Errorf("ante must not be negative, got %d", cfg.Ante)
+	case cfg.Button < 0 || cfg.Button >= n:
+		return nil, fmt.Errorf("button seat %d does not exist", cfg.Button)
+	case len(deck.Cards) < 2*n+5:
+		return nil, fmt.Errorf("deck has %d cards, need %d", len(deck.Cards), 2*n+5)
+	}
+
+	h := &Hand{
+		Config:  cfg,
+		Deck:    deck,
+		Players: make([]Player, n),
+	}
+	for i, stack := range stacks {
+		if stack <= 0 {
+			return nil, fmt.Errorf("seat %d has no chips", i)
+		}
+		h.Players[i].Stack = stack
+		h.Players[i].raisesSeen = -1
+	}
+
+	if cfg.Ante > 0 {
+		for _, seat := range h.fromButton() {
+			h.post(seat, cfg.Ante, PostAnte)
+		}
+	}
+	sb, bb := h.next(cfg.Button), h.next(h.next(cfg.Button))
+	if n == 2 {
+		sb, bb = cfg.Button, h.next(cfg.Button)
+	}
+	h.post(sb, cfg.SmallBlind, PostSmallBlind)
+	h.post(bb, cfg.BigBlind, PostBigBlind)
+	h.CurrentBet = cfg.BigBlind
+	h.MinRaise = cfg.BigBlind
+
+	for range 2 {
+		for _, seat := range h.fromButton() {
+			cards, err := deck.Deal(1)
+			if err != nil {
+				return nil, err
+			}
+			h.Players[seat].Hole = append(h.Players[seat].Hole, cards[0])
+		}
+	}
+
+	h.ToAct = bb
+	if err := h.advance(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// next returns the seat to the left of seat.
+func (h *Hand) next(seat int) int {
+	return (seat + 1) % len(h.Players)
+}
+
+// fromButton returns every seat in order, starting left of the button.
+func (h *Hand) fromButton() []int {
+	seats := make([]int, len(h.Players))
+	for i := range seats {
+		seats[i] = (h.Config.Button + 1 + i) % len(seats)
+	}
+	return seats
+}
+
+// canAct reports whether a player still makes betting decisions.
+func (p *Player) canAct() bool {
+	return !p.Folded && !p.AllIn
+}
+
+// put moves chips from a player's stack into the current street's bet.
+func (h *Hand) put(p *Player, chips int) {
+	p.Stack -= chips
+	p.Bet += chips
+	p.Contributed += chips
+	p.AllIn = p.Stack == 0
+}
+
+// post records a forced bet of up to amount chips. Antes are dead money and
+// do not count toward the player's street bet.
+func (h *Hand) post(seat, amount int, kind ActionType) {
+	p := &h.Players[seat]
+	chips := min(amount, p.Stack)
+	if kind == PostAnte {
+		p.Stack -= chips
+		p.Contributed += chips
+		p.AllIn = p.Stack == 0
+	} else {
+		h.put(p, chips)
+	}
+	h.Actions = append(h.Actions, Action{Seat: seat, Type: kind, Amount: chips, Street: Preflop, AllIn: p.AllIn})
+}
+
+// LegalActions reports what the player to act may do. It returns a Legal
+// with Seat -1 and no actions once the hand is complete.
+//
+// A player may always fold, checks or calls depending on the bet they
+// face, and may bet or raise while they have chips beyond a call and
+// another player can still respond. Config.Betting sets the size of bets
+// and raises; a player may always go all-in for less. An all-in raise
+// smaller than the minimum does not reopen the betting to players who
+// have already acted.
+func (h *Hand) LegalActions() Legal {
+	if h.Street == Complete {
+		return Legal{Seat: -1}
+	}
+	p := &h.Players[h.ToAct]
+	legal := Legal{Seat: h.ToAct, Actions: []ActionType{Fold}}
+
+	toCall := h.CurrentBet - p.Bet
+	if toCall <= 0 {
+		legal.Actions = append(legal.Actions, Check)
+	} else {
+		legal.Call = min(toCall, p.Stack)
+		legal.Actions = append(legal.Actions, Call)
+	}
+
+	opponents := 0
+	for i := range h.Players {
+		if i != h.ToAct && h.Players[i].canAct() {
+			opponents++
+		}
+	}
+	reopened := h.fullRaises > p.raisesSeen
+	if p.Stack <= toCall || opponents == 0 || !reopened {
+		return legal
+	}
+	minTo, maxTo, ok := h.betting().RaiseLimits(BetState{
+		Round:      int(h.Street),
+		Bet:        p.Bet,
+		CurrentBet: h.CurrentBet,
+		MinRaise:   h.MinRaise,
+		Pot:        h.Pot(),
+		BigBlind:   h.Config.BigBlind,
+	})
+	if !ok {
+		return legal
+	}
+	legal.MaxTo = min(maxTo, p.Bet+p.Stack)
+	legal.MinTo = min(minTo, legal.MaxTo)
+	if h.CurrentBet == 0 {
+		legal.Actions = append(legal.Actions, Bet)
+	} else {
+		legal.Actions = append(legal.Actions, Raise)
+	}
+	return legal
+}
+
+// betting returns the hand's betting structure.
+func (h *Hand) betting() BettingStructure {
+	if h.Config.Betting == nil {
+		return NoLimit{}
+	}
+	return h.Config.Betting
+}
+
+// Pot returns every chip put in so far, antes, blinds and bets on the
+// current street included.
+func (h *Hand) Pot() int {
+	total := 0
+	for i := range h.Players {
+		total += h.Players[i].Contributed
+	}
+	return total
+}
+
+// Act plays an action for the player to act and moves the hand forward,
+// dealing streets and settling the pots as rounds and the hand end.
+// Returns an error, leaving the hand unchanged, if the hand is complete,
+// it is not the seat's turn, or the action or amount is not legal (see
+// LegalActions).
+func (h *Hand) Act(a Action) error {
+	if h.Street == Complete {
+		return fmt.Errorf("the hand is complete")
+	}
+	if a.Seat != h.ToAct {
+		return fmt.Errorf("seat %d cannot act, seat %d is to act", a.Seat, h.ToAct)
+	}
+	legal := h.LegalActions()
+	if !slices.Contains(legal.Actions, a.Type) {
+		return fmt.Errorf("seat %d cannot %v now", a.Seat, a.Type)
+	}
+
+	p := &h.Players[a.Seat]
+	record := Action{Seat: a.Seat, Type: a.Type, Street: h.Street}
+	switch a.Type {
+	case Fold:
+		p.Folded = true
+	case Call:
+		h.put(p, legal.Call)
+		record.Amount = legal.Call
+	case Bet, Raise:
+		if a.Amount < legal.MinTo || a.Amount > legal.MaxTo {
+			return fmt.Errorf("seat %d must %v to between %d and %d, got %d",
+				a.Seat, a.Type, legal.MinTo, legal.MaxTo, a.Amount)
+		}
+		if raise := a.Amount - h.CurrentBet; raise >= h.MinRaise {
+			h.MinRaise = raise
+			h.fullRaises++
+		}
+		h.put(p, a.Amount-p.Bet)
+		h.CurrentBet = a.Amount
+		record.Amount = a.Amount
+		for i := range h.Players {
+			h.Players[i].acted = false
+		}
+	}
+	p.acted = true
+	p.raisesSeen = h.fullRaises
+	record.AllIn = p.AllIn
+	h.Actions = append(h.Actions, record)
+
+	return h.advance()
+}
+
+// live returns the seats that have not folded.
+func (h *Hand) live() []int {
+	var seats []int
+	for i := range h.Players {
+		if !h.Players[i].Folded {
+			seats = append(seats, i)
+		}
+	}
+	return seats
+}
+
+// nextToAct returns the first seat after from that still has to act on
+// this street, or -1 if the betting round is over.
+func (h *Hand) nextToAct(from int) int {
+	actors := 0
+	for i := range h.Players {
+		if h.Players[i].canAct() {
+			actors++
+		}
+	}
+	for k := 1; k <= len(h.Players); k++ {
+		seat := (from + k) % len(h.Players)
+		p := &h.Players[seat]
+		if !p.canAct() {
+			continue
+		}
+		// With nobody left to bet against, only a call remains to be made
+		if p.Bet < h.CurrentBet || (!p.acted && actors > 1) {
+			return seat
+		}
+	}
+	return -1
+}
+
+// advance moves the turn to the next player, or ends the betting round
+// when nobody else has to act.
+func (h *Hand) advance() error {
+	if len(h.live()) == 1 {
+		return h.finish()
+	}
+	if seat := h.nextToAct(h.ToAct); seat >= 0 {
+		h.ToAct = seat
+		return nil
+	}
+	return h.endRound()
+}
+
+// endRound clears the street's bets and deals the next street, skipping
+// straight to the showdown when no more betting is possible.
+func (h *Hand) endRound() error {
+	for i := range h.Players {
+		p := &h.Players[i]
+		p.Bet, p.acted, p.raisesSeen = 0, false, -1
+	}
+	h.CurrentBet, h.MinRaise, h.fullRaises = 0, h.Config.BigBlind, 0
+
+	if h.Street == River {
+		return h.finish()
+	}
+	n := 1
+	if h.Street == Preflop {
+		n = 3
+	}
+	cards, err := h.Deck.Deal(n)
+	if err != nil {
+		return fmt.Errorf("dealing the %v: %w", h.Street+1, err)
+	}
+	h.Board = append(h.Board, cards...)
+	h.Street++
+
+	if seat := h.nextToAct(h.Config.Button); seat >= 0 {
+		h.ToAct = seat
+		return nil
+	}
+	return h.endRound()
+}
+
+// finish shows down the live hands, if more than one remains, and settles
+// the pots. Odd chips go to the first winner left of the button.
+func (h *Hand) finish() error {
+	stakes := make([]poker.Stake, len(h.Players))
+	players := make([]poker.ShowdownPlayer, len(h.Players))
+	for i := range h.Players {
+		p := &h.Players[i]
+		stakes[i] = poker.Stake{Contributed: p.Contributed, Folded: p.Folded}
+		players[i] = poker.ShowdownPlayer{Hole: p.Hole}
+	}
+	settlement, hands, err := poker.SettleTable(h.Board, h.Config.Button, stakes, players)
+	if err != nil {
+		return err
+	}
+
+	h.Settlement = settlement
+	for i := range h.Players {
+		p := &h.Players[i]
+		p.Hand = hands[i]
+		p.Won = settlement.Payouts[i]
+		p.Stack += p.Won + settlement.Refunds[i]
+		p.Bet = 0
+	}
+	h.Street = Complete
+	h.ToAct = -1
+	return nil
+}
@@ -0,0 +1,447 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// parseCards parses card notation, failing the test on error.
+func parseCards(t *testing.T, notations ...string) []poker.Card {
+	t.Helper()
+	cards := make([]poker.Card, len(notations))
+	for i, s := range notations {
+		card, err := poker.ParseCard(s)
+		if err != nil {
+			t.Fatalf("ParseCard(%q): %v", s, err)
+		}
+		cards[i] = card
+	}
+	return cards
+}
+
+// stackDeck builds a deck that deals each seat its two hole cards and then
+// the board, for a hand with the given button.
+func stackDeck(t *testing.T, button int, holes [][]string, board ...string) *poker.Deck {
+	t.Helper()
+	n := len(holes)
+	var cards []poker.Card
+	for round := range 2 {
+		for i := range n {
+			cards = append(cards, parseCards(t, holes[(button+1+i)%n][round])...)
+		}
+	}
+	cards = append(cards, parseCards(t, board...)...)
+	return &poker.Deck{Cards: cards}
+}
+
+// newHand starts a hand with 10/20 blinds and fails the test on error.
+func newHand(t *testing.T, deck *poker.Deck, stacks []int, cfg Config) *Hand {
+	t.Helper()
+	if cfg.SmallBlind == 0 {
+		cfg.SmallBlind, cfg.BigBlind = 10, 20
+	}
+	h, err := NewHand(deck, stacks, cfg)
+	if err != nil {
+		t.Fatalf("NewHand: %v", err)
+	}
+	return h
+}
+
+// play plays actions in order, failing the test on the first error.
+func play(t *testing.T, h *Hand, actions ...Action) {
+	t.Helper()
+	for _, a := range actions {
+		if err := h.Act(a); err != nil {
+			t.Fatalf("Act(%+v): %v", a, err)
+		}
+	}
+}
+
+// stacks returns every player's stack.
+func stacks(h *Hand) []int {
+	s := make([]int, len(h.Players))
+	for i, p := range h.Players {
+		s[i] = p.Stack
+	}
+	return s
+}
+
+// threeHanded is a deck where seat 2 makes a flush, seat 0 two pair and
+// seat 1 one pair.
+func threeHanded(t *testing.T, button int) *poker.Deck {
+	return stackDeck(t, button,
+		[][]string{{"Kc", "Kd"}, {"Qs", "Jd"}, {"Ah", "4h"}},
+		"Kh", "7h", "2c", "Qh", "9s")
+}
+
+// TestNewHandForcedBets verifies antes, blinds, hole cards and the first
+// player to act.
+func TestNewHandForcedBets(t *testing.T) {
+	tests := []struct {
+		name    string
+		players int
+		cfg     Config
+		bets    []int
+		stacks  []int
+		toAct   int
+	}{
+		{"three-handed", 3, Config{SmallBlind: 10, BigBlind: 20}, []int{0, 10, 20}, []int{1000, 990, 980}, 0},
+		{"button on seat 2", 3, Config{SmallBlind: 10, BigBlind: 20, Button: 2}, []int{10, 20, 0}, []int{990, 980, 1000}, 2},
+		{"heads-up button posts small blind", 2, Config{SmallBlind: 10, BigBlind: 20}, []int{10, 20}, []int{990, 980}, 0},
+		{"antes are dead money", 4, Config{SmallBlind: 10, BigBlind: 20, Ante: 5}, []int{0, 10, 20, 0}, []int{995, 985, 975, 995}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := make([]int, tt.players)
+			for i := range s {
+				s[i] = 1000
+			}
+			h := newHand(t, poker.NewDeck(), s, tt.cfg)
+
+			for i, p := range h.Players {
+				if p.Bet != tt.bets[i] {
+					t.Errorf("seat %d Bet = %d, want %d", i, p.Bet, tt.bets[i])
+				}
+				if len(p.Hole) != 2 {
+					t.Errorf("seat %d has %d hole cards, want 2", i, len(p.Hole))
+				}
+			}
+			if got := stacks(h); !reflect.DeepEqual(got, tt.stacks) {
+				t.Errorf("stacks = %v, want %v", got, tt.stacks)
+			}
+			if h.ToAct != tt.toAct {
+				t.Errorf("ToAct = %d, want %d", h.ToAct, tt.toAct)
+			}
+			if h.Street != Preflop || h.CurrentBet != 20 || h.MinRaise != 20 {
+				t.Errorf("Street %v, CurrentBet %d, MinRaise %d", h.Street, h.CurrentBet, h.MinRaise)
+			}
+		})
+	}
+}
+
+// TestNewHandDealOrder verifies that cards are dealt one at a time starting
+// left of the button, then the board.
+func TestNewHandDealOrder(t *testing.T) {
+	h := newHand(t, threeHanded(t, 1), []int{1000, 1000, 1000}, Config{Button: 1})
+	for seat, want := range [][]string{{"Kc", "Kd"}, {"Qs", "Jd"}, {"Ah", "4h"}} {
+		if got := h.Players[seat].Hole; !reflect.DeepEqual(got, parseCards(t, want...)) {
+			t.Errorf("seat %d Hole = %v, want %v", seat, got, want)
+		}
+	}
+}
+
+// TestNewHandErrors verifies validation of the table setup.
+func TestNewHandErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		deck   *poker.Deck
+		stacks []int
+		cfg    Config
+	}{
+		{"nil deck", nil, []int{100, 100}, Config{SmallBlind: 1, BigBlind: 2}},
+		{"one player", poker.NewDeck(), []int{100}, Config{SmallBlind: 1, BigBlind: 2}},
+		{"empty stack", poker.NewDeck(), []int{100, 0}, Config{SmallBlind: 1, BigBlind: 2}},
+		{"no blinds", poker.NewDeck(), []int{100, 100}, Config{}},
+		{"small blind above big blind", poker.NewDeck(), []int{100, 100}, Config{SmallBlind: 5, BigBlind: 2}},
+		{"negative ante", poker.NewDeck(), []int{100, 100}, Config{SmallBlind: 1, BigBlind: 2, Ante: -1}},
+		{"missing button seat", poker.NewDeck(), []int{100, 100}, Config{SmallBlind: 1, BigBlind: 2, Button: 2}},
+		{"short deck", &poker.Deck{Cards: parseCards(t, "Ah", "Kh", "Qh", "Jh", "Th", "9h", "8h", "7h")}, []int{100, 100}, Config{SmallBlind: 1, BigBlind: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewHand(tt.deck, tt.stacks, tt.cfg); err == nil {
+				t.Error("NewHand should fail")
+			}
+		})
+	}
+}
+
+// TestHandShowdown plays a hand to the river and checks the board, the
+// winner and that every chip is accounted for.
+func TestHandShowdown(t *testing.T) {
+	h := newHand(t, threeHanded(t, 0), []int{1000, 1000, 1000}, Config{})
+
+	play(t, h,
+		Action{Seat: 0, Type: Call},
+		Action{Seat: 1, Type: Call},
+		Action{Seat: 2, Type: Check},
+	)
+	if h.Street != Flop || len(h.Board) != 3 || h.ToAct != 1 {
+		t.Fatalf("after preflop: Street %v, board %v, ToAct %d", h.Street, h.Board, h.ToAct)
+	}
+	play(t, h,
+		Action{Seat: 1, Type: Check},
+		Action{Seat: 2, Type: Bet, Amount: 40},
+		Action{Seat: 0, Type: Raise, Amount: 120},
+		Action{Seat: 1, Type: Fold},
+		Action{Seat: 2, Type: Call},
+		// Turn
+		Action{Seat: 2, Type: Check},
+		Action{Seat: 0, Type: Check},
+		// River
+		Action{Seat: 2, Type: Bet, Amount: 200},
+		Action{Seat: 0, Type: Call},
+	)
+
+	if h.Street != Complete || h.ToAct != -1 {
+		t.Fatalf("Street = %v, ToAct = %d, want Complete, -1", h.Street, h.ToAct)
+	}
+	if want := parseCards(t, "Kh", "7h", "2c", "Qh", "9s"); !reflect.DeepEqual(h.Board, want) {
+		t.Errorf("Board = %v, want %v", h.Board, want)
+	}
+	if got := h.Players[2].Hand.Category; got != poker.Flush {
+		t.Errorf("winner Category = %v, want Flush", got)
+	}
+	if h.Players[1].Hand != nil {
+		t.Errorf("folded seat has hand %v", h.Players[1].Hand)
+	}
+	if want := []int{660, 980, 1360}; !reflect.DeepEqual(stacks(h), want) {
+		t.Errorf("stacks = %v, want %v", stacks(h), want)
+	}
+	if h.Players[2].Won != 700 {
+		t.Errorf("Won = %d, want 700", h.Players[2].Won)
+	}
+	if err := h.Act(Action{Seat: 0, Type: Check}); err == nil {
+		t.Error("acting after the hand is complete should fail")
+	}
+}
+
+// TestHandUncontested verifies that the last player left wins without a
+// showdown and gets an uncalled bet back.
+func TestHandUncontested(t *testing.T) {
+	h := newHand(t, threeHanded(t, 0), []int{1000, 1000, 1000}, Config{})
+	play(t, h,
+		Action{Seat: 0, Type: Raise, Amount: 60},
+		Action{Seat: 1, Type: Fold},
+		Action{Seat: 2, Type: Fold},
+	)
+
+	if h.Street != Complete || len(h.Board) != 0 {
+		t.Fatalf("Street = %v, board %v, want Complete with no board", h.Street, h.Board)
+	}
+	if want := []int{1030, 990, 980}; !reflect.DeepEqual(stacks(h), want) {
+		t.Errorf("stacks = %v, want %v", stacks(h), want)
+	}
+	if h.Settlement.Refunds[0] != 40 {
+		t.Errorf("Refunds = %v, want 40 back to seat 0", h.Settlement.Refunds)
+	}
+	if h.Players[0].Hand != nil {
+		t.Errorf("Hand = %v, want nil without a showdown", h.Players[0].Hand)
+	}
+}
+
+// TestHandBigBlindOption verifies that the big blind may check or raise
+// when the small blind only calls.
+func TestHandBigBlindOption(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000}, Config{})
+	play(t, h, Action{Seat: 0, Type: Call})
+
+	legal := h.LegalActions()
+	want := Legal{Seat: 1, Actions: []ActionType{Fold, Check, Raise}, MinTo: 40, MaxTo: 1000}
+	if !reflect.DeepEqual(legal, want) {
+		t.Errorf("LegalActions() = %+v, want %+v", legal, want)
+	}
+
+	// Heads-up, the big blind acts first after the flop
+	play(t, h, Action{Seat: 1, Type: Check})
+	if h.Street != Flop || h.ToAct != 1 {
+		t.Errorf("Street = %v, ToAct = %d, want Flop, 1", h.Street, h.ToAct)
+	}
+}
+
+// TestHandMinRaise verifies bet and raise bounds.
+func TestHandMinRaise(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000, 1000}, Config{})
+
+	if err := h.Act(Action{Seat: 0, Type: Raise, Amount: 30}); err == nil {
+		t.Error("raise below the minimum should fail")
+	}
+	if err := h.Act(Action{Seat: 0, Type: Raise, Amount: 1001}); err == nil {
+		t.Error("raise above the stack should fail")
+	}
+	if err := h.Act(Action{Seat: 0, Type: Bet, Amount: 60}); err == nil {
+		t.Error("bet facing the big blind should fail")
+	}
+	if err := h.Act(Action{Seat: 1, Type: Call}); err == nil {
+		t.Error("acting out of turn should fail")
+	}
+
+	play(t, h, Action{Seat: 0, Type: Raise, Amount: 70}) // Raise of 50
+	if legal := h.LegalActions(); legal.MinTo != 120 || legal.Call != 60 {
+		t.Errorf("MinTo = %d, Call = %d, want 120, 60", legal.MinTo, legal.Call)
+	}
+	if err := h.Act(Action{Seat: 1, Type: Check}); err == nil {
+		t.Error("check facing a raise should fail")
+	}
+}
+
+// TestHandIncompleteRaise verifies that a short all-in raise must be
+// called but does not reopen the betting for players who already acted.
+func TestHandIncompleteRaise(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000, 150}, Config{Button: 2})
+	// Seat 0 small blind, seat 1 big blind, seat 2 button acts first
+	play(t, h,
+		Action{Seat: 2, Type: Call},
+		Action{Seat: 0, Type: Call},
+		Action{Seat: 1, Type: Check},
+		// Flop: seat 0 bets 100, seat 1 calls, seat 2 is all-in for 130
+		Action{Seat: 0, Type: Bet, Amount: 100},
+		Action{Seat: 1, Type: Call},
+		Action{Seat: 2, Type: Raise, Amount: 130},
+	)
+
+	if !h.Players[2].AllIn {
+		t.Fatal("seat 2 should be all-in")
+	}
+	legal := h.LegalActions()
+	want := Legal{Seat: 0, Actions: []ActionType{Fold, Call}, Call: 30}
+	if !reflect.DeepEqual(legal, want) {
+		t.Errorf("LegalActions() = %+v, want %+v", legal, want)
+	}
+}
+
+// TestHandFullRaiseReopens verifies that a full raise after an incomplete
+// one lets earlier players raise again.
+func TestHandFullRaiseReopens(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000, 1000, 130}, Config{})
+	// Seat 3 acts first preflop; blinds are seats 1 and 2
+	play(t, h,
+		Action{Seat: 3, Type: Raise, Amount: 100},
+		Action{Seat: 0, Type: Raise, Amount: 180},
+		Action{Seat: 1, Type: Fold},
+		Action{Seat: 2, Type: Call},
+	)
+	// Seat 3 faced a full raise to 180 but only has 130: call all-in
+	if legal := h.LegalActions(); !reflect.DeepEqual(legal.Actions, []ActionType{Fold, Call}) {
+		t.Errorf("seat 3 Actions = %v, want [Fold Call]", legal.Actions)
+	}
+	play(t, h, Action{Seat: 3, Type: Call})
+	if h.Street != Flop {
+		t.Errorf("Street = %v, want Flop", h.Street)
+	}
+}
+
+// TestHandAllInRunout verifies that the board is dealt out once no more
+// betting is possible, with side pots awarded.
+func TestHandAllInRunout(t *testing.T) {
+	deck := stackDeck(t, 0,
+		[][]string{{"Kc", "Kd"}, {"Ah", "As"}, {"Qh", "Qd"}},
+		"2c", "7d", "9s", "3h", "4c")
+	h := newHand(t, deck, []int{500, 100, 300}, Config{})
+
+	play(t, h,
+		Action{Seat: 0, Type: Raise, Amount: 500},
+		Action{Seat: 1, Type: Call},
+		Action{Seat: 2, Type: Call},
+	)
+
+	if h.Street != Complete || len(h.Board) != 5 {
+		t.Fatalf("Street = %v, board %v, want Complete with 5 cards", h.Street, h.Board)
+	}
+	// Aces win the 300 main pot, kings the 400 side pot, 200 uncalled
+	if want := []int{600, 300, 0}; !reflect.DeepEqual(stacks(h), want) {
+		t.Errorf("stacks = %v, want %v", stacks(h), want)
+	}
+	if len(h.Settlement.Pots) != 2 {
+		t.Errorf("got %d pots, want 2", len(h.Settlement.Pots))
+	}
+}
+
+// TestHandShortBlinds verifies blinds posted all-in for less.
+func TestHandShortBlinds(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 5, 15}, Config{})
+	if !h.Players[1].AllIn || !h.Players[2].AllIn {
+		t.Fatal("both blinds should be all-in")
+	}
+	// Only seat 0 can act and must still match the full big blind
+	if legal := h.LegalActions(); legal.Seat != 0 || legal.Call != 20 {
+		t.Errorf("LegalActions() = %+v, want seat 0 to call 20", legal)
+	}
+	play(t, h, Action{Seat: 0, Type: Call})
+	if h.Street != Complete {
+		t.Errorf("Street = %v, want Complete", h.Street)
+	}
+
+	total := 0
+	for _, s := range stacks(h) {
+		total += s
+	}
+	if total != 1020 {
+		t.Errorf("total chips = %d, want 1020", total)
+	}
+}
+
+// TestHandDeterministic verifies that the same deck and actions always
+// produce the same hand.
+func TestHandDeterministic(t *testing.T) {
+	run := func() *Hand {
+		deck := poker.NewDeck()
+		deck.Shuffle(poker.NewSeededSource(7))
+		h := newHand(t, deck, []int{1000, 1000, 1000}, Config{Ante: 2})
+		play(t, h,
+			Action{Seat: 0, Type: Call},
+			Action{Seat: 1, Type: Call},
+			Action{Seat: 2, Type: Check},
+		)
+		for h.Street != Complete {
+			play(t, h, Action{Seat: h.ToAct, Type: Check})
+		}
+		return h
+	}
+
+	h1, h2 := run(), run()
+	if !reflect.DeepEqual(h1.Board, h2.Board) || !reflect.DeepEqual(stacks(h1), stacks(h2)) ||
+		!reflect.DeepEqual(h1.Actions, h2.Actions) {
+		t.Error("replaying the same deck and actions gave different hands")
+	}
+}
+
+// TestHandPotLimit verifies pot-sized raise bounds in a pot-limit hand.
+func TestHandPotLimit(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000, 1000}, Config{Betting: PotLimit{}})
+
+	// Call 20 into a pot of 30, then raise 50
+	if legal := h.LegalActions(); legal.MinTo != 40 || legal.MaxTo != 70 {
+		t.Errorf("MinTo = %d, MaxTo = %d, want 40, 70", legal.MinTo, legal.MaxTo)
+	}
+	if err := h.Act(Action{Seat: 0, Type: Raise, Amount: 80}); err == nil {
+		t.Error("raise above the pot should fail")
+	}
+	play(t, h, Action{Seat: 0, Type: Raise, Amount: 70})
+	// Small blind calls 60 into a pot of 100, then raises 160
+	if legal := h.LegalActions(); legal.MinTo != 120 || legal.MaxTo != 230 {
+		t.Errorf("MinTo = %d, MaxTo = %d, want 120, 230", legal.MinTo, legal.MaxTo)
+	}
+}
+
+// TestHandFixedLimit verifies fixed bet sizes and the raise cap.
+func TestHandFixedLimit(t *testing.T) {
+	h := newHand(t, poker.NewDeck(), []int{1000, 1000, 1000}, Config{Betting: NewFixedLimit(20, 40)})
+
+	play(t, h,
+		Action{Seat: 0, Type: Raise, Amount: 40},
+		Action{Seat: 1, Type: Raise, Amount: 60},
+		Action{Seat: 2, Type: Raise, Amount: 80},
+	)
+	// The big blind and three raises cap the betting
+	if legal := h.LegalActions(); !reflect.DeepEqual(legal.Actions, []ActionType{Fold, Call}) {
+		t.Errorf("capped Actions = %v, want [Fold Call]", legal.Actions)
+	}
+	play(t, h,
+		Action{Seat: 0, Type: Call},
+		Action{Seat: 1, Type: Call},
+		Action{Seat: 1, Type: Check},
+		Action{Seat: 2, Type: Check},
+		Action{Seat: 0, Type: Check},
+	)
+	if h.Street != Turn {
+		t.Fatalf("Street = %v, want Turn", h.Street)
+	}
+	if legal := h.LegalActions(); legal.MinTo != 40 || legal.MaxTo != 40 {
+		t.Errorf("turn MinTo = %d, MaxTo = %d, want 40, 40", legal.MinTo, legal.MaxTo)
+	}
+}
@@ -0,0 +1,259 @@
+// Package history reads, checks, writes and replays poker hand histories
+// in the formats used by online poker sites.
+package history
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// HandHistory is one hand of hold'em or Omaha as recorded by a poker site.
+// Amounts are whole chips, or cents for amounts written with a currency
+// symbol.
+type HandHistory struct {
+	ID         string    // Hand number
+	Tournament string    // Tournament number; empty for cash games
+	Game       string    // Such as "Hold'em No Limit"
+	Currency   string    // Such as "USD"; empty for tournaments and play money
+	SmallBlind int       // In fixed limit games, the largest small blind posted
+	BigBlind   int       // In fixed limit games, the largest big blind posted
+	SmallBet   int       // Fixed limit bet on the preflop and flop; 0 in other games
+	BigBet     int       // Fixed limit bet on the turn and river; 0 in other games
+	Ante       int       // Largest ante posted; 0 for none
+	Time       time.Time // Start of the hand as printed, without its zone
+	TimeZone   string    // Zone the time was printed in, such as "ET"
+
+	Table    string
+	MaxSeats int // 0 if not given
+	Button   int // Index into Seats of the button, or -1 if that seat is empty
+
+	Seats   []Seat
+	Actions []game.Action // Every action in order; Seat is an index into Seats
+	Board   []poker.Card
+
+	TotalPot int // Pot as reported in the summary, before rake
+	Rake     int
+}
+
+// Seat is one player at the table.
+type Seat struct {
+	Number     int // Seat number as printed, from 1
+	Name       string
+	Stack      int  // Chips at the start of the hand
+	SittingOut bool // Not dealt in
+
+	Hole      []poker.Card // Hole cards if dealt to the hero or shown; nil otherwise
+	Showed    bool         // Showed the hole cards at the showdown
+	Returned  int          // Uncalled bet returned
+	Collected int          // Chips collected from the pots, after rake
+
+	Hand *poker.Hand // Best hand at the showdown; set by Showdown
+}
+
+// FromGame returns the history of a completed hand from the game engine.
+// Seats are numbered from 1 and named "Player 1", "Player 2" and so on,
+// and every player's hole cards are known. The game is named after the
+// betting structure's Name, with fixed limit written as "Hold'em Limit".
+// Returns an error if the hand is not complete or its betting structure is
+// not one of package game's.
+func FromGame(h *game.Hand) (*HandHistory, error) {
+	if h.Street != game.Complete {
+		return nil, fmt.Errorf("the hand is not complete, it is on the %v", h.Street)
+	}
+	hh := &HandHistory{
+		Game:       "Hold'em No Limit",
+		SmallBlind: h.Config.SmallBlind,
+		BigBlind:   h.Config.BigBlind,
+		Ante:       h.Config.Ante,
+		MaxSeats:   len(h.Players),
+		Button:     h.Config.Button,
+		Actions:    slices.Clone(h.Actions),
+		Board:      slices.Clone(h.Board),
+	}
+	if h.Config.Betting != nil {
+		switch name := h.Config.Betting.Name(); name {
+		case "No Limit", "Pot Limit":
+			hh.Game = "Hold'em " + name
+		case "Fixed Limit":
+			// The bet sizes stand in for the blinds in the site's header
+			switch b := h.Config.Betting.(type) {
+			case game.FixedLimit:
+				hh.SmallBet, hh.BigBet = b.SmallBet, b.BigBet
+			case *game.FixedLimit:
+				hh.SmallBet, hh.BigBet = b.SmallBet, b.BigBet
+			default:
+				return nil, fmt.Errorf("fixed limit betting %T has no bet sizes", b)
+			}
+			hh.Game = "Hold'em Limit"
+		default:
+			return nil, fmt.Errorf("unsupported betting structure %q", name)
+		}
+	}
+	for _, pot := range h.Settlement.Pots {
+		hh.TotalPot += pot.Amount
+	}
+	for i, p := range h.Players {
+		refund := h.Settlement.Refunds[i]
+		hh.Seats = append(hh.Seats, Seat{
+			Number:    i + 1,
+			Name:      fmt.Sprintf("Player %d", i+1),
+			Stack:     p.Stack - p.Won - refund + p.Contributed,
+			Hole:      slices.Clone(p.Hole),
+			Showed:    p.Hand != nil,
+			Returned:  refund,
+			Collected: p.Won,
+			Hand:      p.Hand,
+		})
+	}
+	return hh, nil
+}
+
+// Contributions returns the chips each seat put in across the hand, antes
+// and blinds included and uncalled bets not yet returned.
+func (hh *HandHistory) Contributions() []int {
+	total := make([]int, len(hh.Seats))
+	street := make([]int, len(hh.Seats))
+	current := game.Preflop
+	for _, a := range hh.Actions {
+		if a.Street != current {
+			clear(street)
+			current = a.Street
+		}
+		chips := 0
+		switch a.Type {
+		case game.PostAnte:
+			total[a.Seat] += a.Amount
+		case game.Call, game.PostSmallBlind, game.PostBigBlind:
+			chips = a.Amount
+		case game.Bet, game.Raise:
+			chips = a.Amount - street[a.Seat]
+		}
+		street[a.Seat] += chips
+		total[a.Seat] += chips
+	}
+	return total
+}
+
+// checkSeats returns an error if an action names a seat that is not in
+// Seats.
+func (hh *HandHistory) checkSeats() error {
+	for k, a := range hh.Actions {
+		if a.Seat < 0 || a.Seat >= len(hh.Seats) {
+			return fmt.Errorf("hand %s: action %d is by seat index %d, but there are %d seats",
+				cmp.Or(hh.ID, "0"), k+1, a.Seat, len(hh.Seats))
+		}
+	}
+	return nil
+}
+
+// folded returns which seats folded.
+func (hh *HandHistory) folded() []bool {
+	folded := make([]bool, len(hh.Seats))
+	for _, a := range hh.Actions {
+		if a.Type == game.Fold {
+			folded[a.Seat] = true
+		}
+	}
+	return folded
+}
+
+// fixedLimit reports whether the game is played fixed limit, such as
+// "Hold'em Limit".
+func (hh *HandHistory) fixedLimit() bool {
+	return strings.HasSuffix(hh.Game, " Limit") &&
+		!strings.HasSuffix(hh.Game, " No Limit") && !strings.HasSuffix(hh.Game, " Pot Limit")
+}
+
+// bestHand evaluates a seat's hole cards with the board, or returns nil if
+// there are fewer than 5 cards between them.
+func (hh *HandHistory) bestHand(seat *Seat) *poker.Hand {
+	if len(seat.Hole)+len(hh.Board) < 5 {
+		return nil
+	}
+	if strings.HasPrefix(hh.Game, "Omaha") {
+		return poker.FindBestOmahaHand(seat.Hole, hh.Board)
+	}
+	return poker.FindBestHand(append(slices.Clone(seat.Hole), hh.Board...))
+}
+
+// Showdown recomputes the result of the hand. It evaluates the hole cards
+// of every player who showed with the board, using poker.FindBestHand for
+// hold'em and poker.FindBestOmahaHand for Omaha, fills in Seat.Hand, and
+// settles the pots from the chips in Actions with odd chips going left of
+// the button. Players who reached the showdown without showing are
+// treated as having conceded. The settlement is before rake, with one
+// entry per seat in Seats order.
+//
+// Returns an error if the cards are invalid, or several players reached
+// the showdown but nobody showed.
+func (hh *HandHistory) Showdown() (*poker.Settlement, error) {
+	contributed := hh.Contributions()
+	folded := hh.folded()
+	var live []int
+	for i := range hh.Seats {
+		if !folded[i] && contributed[i] > 0 {
+			live = append(live, i)
+		}
+	}
+	if len(live) > 1 {
+		shown := 0
+		for _, i := range live {
+			if hh.Seats[i].Showed {
+				shown++
+			} else {
+				folded[i] = true
+			}
+		}
+		if shown == 0 {
+			return nil, fmt.Errorf("hand %s reached a showdown but nobody showed", hh.ID)
+		}
+	}
+
+	stakes := make([]poker.Stake, len(hh.Seats))
+	players := make([]poker.ShowdownPlayer, len(hh.Seats))
+	for i := range hh.Seats {
+		seat := &hh.Seats[i]
+		stakes[i] = poker.Stake{Contributed: contributed[i], Folded: folded[i] || contributed[i] == 0}
+		players[i] = poker.ShowdownPlayer{Hole: seat.Hole}
+		seat.Hand = nil
+		if seat.Showed {
+			seat.Hand = hh.bestHand(seat)
+			players[i].Hand = seat.Hand
+		}
+	}
+	settlement, _, err := poker.SettleTable(hh.Board, max(hh.Button, 0), stakes, players)
+	if err != nil {
+		return nil, fmt.Errorf("hand %s: %w", hh.ID, err)
+	}
+	return settlement, nil
+}
+
+// Verify recomputes the showdown and checks that the site paid exactly the
+// players who should have won chips. Amounts are not compared, since the
+// site's are after rake.
+// Returns an error describing the difference, or any error from Showdown.
+func (hh *HandHistory) Verify() error {
+	settlement, err := hh.Showdown()
+	if err != nil {
+		return err
+	}
+	var want, got []string
+	for i, seat := range hh.Seats {
+		if settlement.Payouts[i] > 0 {
+			want = append(want, seat.Name)
+		}
+		if seat.Collected > 0 {
+			got = append(got, seat.Name)
+		}
+	}
+	if !slices.Equal(want, got) {
+		return fmt.Errorf("hand %s: site paid %v, recomputed winners are %v", hh.ID, got, want)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package history
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// omahaHand is cashHand played as pot-limit Omaha. Bob's pair of fours
+// would make two pair in hold'em.
+var omahaHand = strings.NewReplacer(
+	"Hold'em No Limit", "Omaha Pot Limit",
+	"[Ah Kd]", "[Ah Kd 4c 4s]",
+	"[Kc Qc]", "[Kc Qc Jd Td]",
+).Replace(cashHand)
+
+// TestContributions verifies the chips each seat put in, counting raises
+// by their total and antes as dead money.
+func TestContributions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []int
+	}{
+		{"cash hand", cashHand, []int{10, 435, 435, 0}},
+		{"uncalled raise", tournamentHand, []int{260, 60, 110}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readHand(t, tt.text).Contributions(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Contributions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHandHistoryShowdown verifies the recomputed hands and pots.
+func TestHandHistoryShowdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		payouts  []int
+		refunds  []int
+		describe []string
+	}{
+		{
+			name:     "kicker decides",
+			text:     cashHand,
+			payouts:  []int{0, 880, 0, 0},
+			refunds:  []int{0, 0, 0, 0},
+			describe: []string{"", "One Pair, Kings with Ace-Nine-Seven kickers", "One Pair, Kings with Queen-Nine-Seven kickers", ""},
+		},
+		{
+			name:     "no showdown",
+			text:     tournamentHand,
+			payouts:  []int{280, 0, 0},
+			refunds:  []int{150, 0, 0},
+			describe: []string{"", "", ""},
+		},
+		{
+			name:     "mucked hand concedes",
+			text:     strings.Replace(cashHand, "Carol: shows [Kc Qc] (a pair of Kings)", "Carol: mucks hand", 1),
+			payouts:  []int{0, 880, 0, 0},
+			refunds:  []int{0, 0, 0, 0},
+			describe: []string{"", "One Pair, Kings with Ace-Nine-Seven kickers", "", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hh := readHand(t, tt.text)
+			settlement, err := hh.Showdown()
+			if err != nil {
+				t.Fatalf("Showdown: %v", err)
+			}
+			if !reflect.DeepEqual(settlement.Payouts, tt.payouts) || !reflect.DeepEqual(settlement.Refunds, tt.refunds) {
+				t.Errorf("Payouts = %v, Refunds = %v, want %v, %v", settlement.Payouts, settlement.Refunds, tt.payouts, tt.refunds)
+			}
+			for i, seat := range hh.Seats {
+				got := ""
+				if seat.Hand != nil {
+					got = seat.Hand.Describe()
+				}
+				if got != tt.describe[i] {
+					t.Errorf("seat %d Hand = %q, want %q", i, got, tt.describe[i])
+				}
+			}
+		})
+	}
+}
+
+// TestHandHistoryShowdownErrors verifies that a showdown without any
+// shown hand is rejected.
+func TestHandHistoryShowdownErrors(t *testing.T) {
+	text := strings.Replace(cashHand, "Carol: shows [Kc Qc] (a pair of Kings)", "Carol: mucks hand", 1)
+	text = strings.Replace(text, "Bob: shows [Ah Kd] (a pair of Kings)", "Bob: mucks hand", 1)
+	text = strings.Replace(text, "showed [Kc Qc]", "mucked", 1)
+	hh := readHand(t, strings.Replace(text, "showed [Ah Kd]", "mucked", 1))
+	if _, err := hh.Showdown(); err == nil {
+		t.Error("Showdown() should fail when nobody showed")
+	}
+}
+
+// TestVerify verifies that the site's winners are checked against the
+// recomputed showdown.
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{"correct showdown", cashHand, false},
+		{"correct uncontested pot", tournamentHand, false},
+		{"wrong winner", strings.Replace(cashHand, "Bob collected $8.37", "Carol collected $8.37", 1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := readHand(t, tt.text).Verify()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestFromGame verifies converting a completed engine hand, and that the
+// engine's result passes verification.
+func TestFromGame(t *testing.T) {
+	h := playedHand(t)
+	hh, err := FromGame(h)
+	if err != nil {
+		t.Fatalf("FromGame: %v", err)
+	}
+
+	if hh.Game != "Hold'em No Limit" || hh.Button != 0 || len(hh.Board) != 5 {
+		t.Errorf("Game %q, Button %d, Board %v", hh.Game, hh.Button, hh.Board)
+	}
+	for i, s := range hh.Seats {
+		p := h.Players[i]
+		if s.Stack != []int{1000, 1000, 500}[i] || s.Collected != p.Won || !s.Showed || s.Hand != p.Hand {
+			t.Errorf("seat %d = %+v", i, s)
+		}
+	}
+	if !reflect.DeepEqual(hh.Actions, h.Actions) {
+		t.Errorf("Actions = %v, want %v", hh.Actions, h.Actions)
+	}
+	if err := hh.Verify(); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	unfinished, _ := game.NewHand(poker.NewDeck(), []int{100, 100}, game.Config{SmallBlind: 1, BigBlind: 2})
+	if _, err := FromGame(unfinished); err == nil {
+		t.Error("FromGame() on an unfinished hand should fail")
+	}
+}
+
+// TestFromGameBetting verifies the game name and bet sizes written for each
+// betting structure, given by value or by pointer.
+func TestFromGameBetting(t *testing.T) {
+	limit := game.NewFixedLimit(20, 40)
+	tests := []struct {
+		name    string
+		betting game.BettingStructure
+		game    string
+		bets    [2]int
+	}{
+		{"default", nil, "Hold'em No Limit", [2]int{}},
+		{"no limit", game.NoLimit{}, "Hold'em No Limit", [2]int{}},
+		{"pot limit", game.PotLimit{}, "Hold'em Pot Limit", [2]int{}},
+		{"pot limit pointer", &game.PotLimit{}, "Hold'em Pot Limit", [2]int{}},
+		{"fixed limit", limit, "Hold'em Limit", [2]int{20, 40}},
+		{"fixed limit pointer", &limit, "Hold'em Limit", [2]int{20, 40}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := game.Config{SmallBlind: 10, BigBlind: 20, Betting: tt.betting}
+			h, err := game.NewHand(poker.NewDeck(), []int{1000, 1000, 1000}, cfg)
+			if err != nil {
+				t.Fatalf("NewHand: %v", err)
+			}
+			for h.Street != game.Complete {
+				if err := h.Act(game.Action{Seat: h.ToAct, Type: game.Fold}); err != nil {
+					t.Fatalf("Act: %v", err)
+				}
+			}
+			hh, err := FromGame(h)
+			if err != nil {
+				t.Fatalf("FromGame: %v", err)
+			}
+			if hh.Game != tt.game || [2]int{hh.SmallBet, hh.BigBet} != tt.bets {
+				t.Errorf("Game %q, bets %d/%d, want %q, %v", hh.Game, hh.SmallBet, hh.BigBet, tt.game, tt.bets)
+			}
+		})
+	}
+}
+
+// spreadLimit is a betting structure package history does not know.
+type spreadLimit struct{ game.NoLimit }
+
+func (spreadLimit) Name() string { return "Spread Limit" }
+
+// TestFromGameUnknownBetting verifies that a hand with a betting structure
+// outside package game is rejected rather than mislabelled.
+func TestFromGameUnknownBetting(t *testing.T) {
+	cfg := game.Config{SmallBlind: 10, BigBlind: 20, Betting: spreadLimit{}}
+	h, err := game.NewHand(poker.NewDeck(), []int{1000, 1000}, cfg)
+	if err != nil {
+		t.Fatalf("NewHand: %v", err)
+	}
+	for h.Street != game.Complete {
+		if err := h.Act(game.Action{Seat: h.ToAct, Type: game.Fold}); err != nil {
+			t.Fatalf("Act: %v", err)
+		}
+	}
+	_, err = FromGame(h)
+	if want := `unsupported betting structure "Spread Limit"`; err == nil || err.Error() != want {
+		t.Errorf("FromGame() error = %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,647 @@
+package history
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// Reader reads PokerStars hand histories for hold'em and Omaha, one hand at
+// a time, from text where hands are separated by blank lines. Hi/Lo games,
+// variants such as 6+ Hold'em and hands run more than once are not
+// supported.
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewReader returns a Reader reading from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{scanner: bufio.NewScanner(r)}
+}
+
+// Read returns the next hand, or io.EOF when there are no more. Errors
+// give the line number in the input where the hand is malformed.
+func (r *Reader) Read() (*HandHistory, error) {
+	var lines []string
+	first := 0
+	for r.scanner.Scan() {
+		r.line++
+		text := strings.TrimSpace(strings.TrimPrefix(r.scanner.Text(), "\ufeff"))
+		if text == "" {
+			if lines != nil {
+				break
+			}
+			continue
+		}
+		if lines == nil {
+			first = r.line
+		}
+		lines = append(lines, text)
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.line+1, err)
+	}
+	if lines == nil {
+		return nil, io.EOF
+	}
+	p := &parser{hh: &HandHistory{Button: -1}, first: first, dealt: map[poker.Card]int{}}
+	return p.parse(lines)
+}
+
+// ReadAll reads every remaining hand.
+func (r *Reader) ReadAll() ([]*HandHistory, error) {
+	var hands []*HandHistory
+	for {
+		hh, err := r.Read()
+		if err == io.EOF {
+			return hands, nil
+		}
+		if err != nil {
+			return hands, err
+		}
+		hands = append(hands, hh)
+	}
+}
+
+// section is the part of a hand history being parsed.
+type section int
+
+const (
+	seatsSection section = iota
+	bettingSection
+	showdownSection
+	summarySection
+)
+
+// parser holds the state for parsing one hand.
+type parser struct {
+	hh           *HandHistory
+	first        int // Input line number of the hand's first line
+	line         int // Input line number being parsed
+	section      section
+	street       game.Street
+	holeCards    bool // The hole cards have been dealt
+	buttonNumber int
+	dealt        map[poker.Card]int // Line each known card was first seen on
+}
+
+// errorf returns an error for the line being parsed.
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// parse parses the lines of one hand.
+func (p *parser) parse(lines []string) (*HandHistory, error) {
+	p.line = p.first
+	if err := p.header(lines[0]); err != nil {
+		return nil, err
+	}
+	if len(lines) < 2 {
+		return nil, p.errorf("hand %s ends after the header", p.hh.ID)
+	}
+	p.line++
+	if err := p.table(lines[1]); err != nil {
+		return nil, err
+	}
+
+	for k, text := range lines[2:] {
+		p.line = p.first + 2 + k
+		var err error
+		switch {
+		case strings.HasPrefix(text, "*** "):
+			err = p.marker(text)
+		case p.section == seatsSection && strings.HasPrefix(text, "Seat "):
+			err = p.seat(text)
+		case p.section == summarySection:
+			err = p.summary(text)
+		default:
+			if p.section == seatsSection {
+				if len(p.hh.Seats) == 0 {
+					return nil, p.errorf("hand %s has no seats", p.hh.ID)
+				}
+				p.section = bettingSection
+			}
+			err = p.event(text)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.section != summarySection {
+		return nil, p.errorf("hand %s ends without a summary", p.hh.ID)
+	}
+
+	if i := p.hh.seatNumber(p.buttonNumber); i >= 0 {
+		p.hh.Button = i
+	}
+	return p.hh, nil
+}
+
+// header parses the first line, such as
+//
+//	PokerStars Hand #123: Hold'em No Limit ($0.01/$0.02 USD) - 2024/01/02 12:00:00 ET
+//	PokerStars Hand #123: Tournament #456, $1+$0.10 USD Hold'em No Limit - Level I (10/20) - 2024/01/02 12:00:00 ET
+func (p *parser) header(text string) error {
+	rest, ok := strings.CutPrefix(text, "PokerStars ")
+	if ok {
+		_, rest, ok = strings.Cut(rest, "Hand #")
+	}
+	var id string
+	if ok {
+		id, rest, ok = strings.Cut(rest, ": ")
+	}
+	if !ok || !isDigits(id) {
+		return p.errorf("not a PokerStars hand header: %q", text)
+	}
+	p.hh.ID = id
+
+	parts := strings.Split(rest, " - ")
+	if len(parts) < 2 {
+		return p.errorf("hand %s: header has no date", id)
+	}
+	gameText := parts[0]
+	if t, ok := strings.CutPrefix(gameText, "Tournament #"); ok {
+		p.hh.Tournament, gameText, _ = strings.Cut(t, ", ")
+	}
+	start := strings.Index(gameText, "Hold'em")
+	if omaha := strings.Index(gameText, "Omaha"); start < 0 || (omaha >= 0 && omaha < start) {
+		start = omaha
+	}
+	// Variants such as "6+ Hold'em" and "5 Card Omaha" name themselves
+	// before the game; only a tournament's buy-in may come first
+	prefix := gameText[:max(start, 0)]
+	if start < 0 || strings.Contains(gameText, "Hi/Lo") ||
+		(p.hh.Tournament == "" && prefix != "") || !isBuyIn(prefix) {
+		return p.errorf("hand %s: unsupported game %q", id, gameText)
+	}
+	p.hh.Game, _, _ = strings.Cut(gameText[start:], " (")
+
+	// The stakes are in the last parentheses before the date. They are the
+	// blinds, except in fixed limit games where they are the bet sizes and
+	// the blinds are taken from the posts.
+	stakes := ""
+	for _, part := range parts[:len(parts)-1] {
+		if open := strings.LastIndex(part, "("); open >= 0 && strings.HasSuffix(part, ")") {
+			stakes = part[open+1 : len(part)-1]
+		}
+	}
+	fields := strings.Fields(stakes)
+	if len(fields) == 0 {
+		return p.errorf("hand %s: header has no stakes", id)
+	}
+	blinds := strings.Split(fields[0], "/")
+	if len(blinds) != 2 {
+		return p.errorf("hand %s: invalid stakes %q", id, stakes)
+	}
+	small, err := p.amount(blinds[0])
+	if err != nil {
+		return err
+	}
+	big, err := p.amount(blinds[1])
+	if err != nil {
+		return err
+	}
+	if p.hh.fixedLimit() {
+		p.hh.SmallBet, p.hh.BigBet = small, big
+	} else {
+		p.hh.SmallBlind, p.hh.BigBlind = small, big
+	}
+	if len(fields) > 1 {
+		p.hh.Currency = fields[1]
+	}
+
+	// A second time in brackets may follow: "2024/01/02 18:00:00 CET [2024/01/02 12:00:00 ET]"
+	date, _, _ := strings.Cut(parts[len(parts)-1], " [")
+	fields = strings.Fields(date)
+	if len(fields) < 2 {
+		return p.errorf("hand %s: invalid date %q", id, date)
+	}
+	if p.hh.Time, err = time.Parse("2006/1/2 15:04:05", fields[0]+" "+fields[1]); err != nil {
+		return p.errorf("hand %s: invalid date %q", id, date)
+	}
+	if len(fields) > 2 {
+		p.hh.TimeZone = fields[2]
+	}
+	return nil
+}
+
+// table parses the second line, such as
+//
+//	Table 'Alpha II' 6-max Seat #3 is the button
+func (p *parser) table(text string) error {
+	rest, ok := strings.CutPrefix(text, "Table '")
+	end := strings.LastIndex(rest, "'")
+	if !ok || end < 0 {
+		return p.errorf("hand %s: invalid table line %q", p.hh.ID, text)
+	}
+	p.hh.Table = rest[:end]
+	rest = rest[end+1:]
+
+	if i := strings.Index(rest, "-max"); i >= 0 {
+		fields := strings.Fields(rest[:i])
+		if len(fields) > 0 {
+			p.hh.MaxSeats, _ = strconv.Atoi(fields[len(fields)-1])
+		}
+	}
+	_, button, ok := strings.Cut(rest, "Seat #")
+	if ok {
+		button, ok = strings.CutSuffix(button, " is the button")
+	}
+	n, err := strconv.Atoi(button)
+	if !ok || err != nil {
+		return p.errorf("hand %s: table line has no button: %q", p.hh.ID, text)
+	}
+	p.buttonNumber = n
+	return nil
+}
+
+// seat parses a seat line, such as
+//
+//	Seat 1: Alice ($2.50 in chips) is sitting out
+func (p *parser) seat(text string) error {
+	number, rest, _ := strings.Cut(strings.TrimPrefix(text, "Seat "), ": ")
+	n, err := strconv.Atoi(number)
+	end := strings.LastIndex(rest, " in chips")
+	open := strings.LastIndex(rest[:max(end, 0)], " (")
+	if err != nil || open < 0 {
+		return p.errorf("invalid seat line %q", text)
+	}
+	after := rest[end+len(" in chips"):]
+	stack, err := p.amount(rest[open+2 : end])
+	if err != nil {
+		return err
+	}
+	if p.hh.seatNumber(n) >= 0 {
+		return p.errorf("seat %d is listed twice", n)
+	}
+	p.hh.Seats = append(p.hh.Seats, Seat{
+		Number:     n,
+		Name:       rest[:open],
+		Stack:      stack,
+		SittingOut: strings.Contains(after, "is sitting out"),
+	})
+	return nil
+}
+
+// marker parses a line starting a new section, such as
+//
+//	*** TURN *** [Ah Kd 7c] [2s]
+func (p *parser) marker(text string) error {
+	name, cards, _ := strings.Cut(strings.TrimPrefix(text, "*** "), " ***")
+	if len(p.hh.Seats) == 0 {
+		return p.errorf("hand %s has no seats", p.hh.ID)
+	}
+	if p.section == summarySection {
+		return p.errorf("unexpected %q after the summary", text)
+	}
+
+	var street game.Street
+	switch name {
+	case "HOLE CARDS":
+		p.section, p.holeCards = bettingSection, true
+		return nil
+	case "SHOW DOWN":
+		p.section = showdownSection
+		return nil
+	case "SUMMARY":
+		p.section = summarySection
+		return nil
+	case "FLOP":
+		street = game.Flop
+	case "TURN":
+		street = game.Turn
+	case "RIVER":
+		street = game.River
+	default:
+		return p.errorf("unsupported section %q", name)
+	}
+	if !p.holeCards || p.section != bettingSection || street != p.street+1 {
+		return p.errorf("unexpected %v", street)
+	}
+
+	// Only the last bracket holds the newly dealt cards
+	open := strings.LastIndex(cards, "[")
+	if open < 0 || !strings.HasSuffix(cards, "]") {
+		return p.errorf("%v has no cards", street)
+	}
+	dealt, err := p.cards(cards[open+1 : len(cards)-1])
+	if err != nil {
+		return err
+	}
+	want := map[game.Street]int{game.Flop: 3, game.Turn: 1, game.River: 1}[street]
+	if len(dealt) != want {
+		return p.errorf("%v has %d cards, want %d", street, len(dealt), want)
+	}
+	if err := p.see(dealt); err != nil {
+		return err
+	}
+	p.hh.Board = append(p.hh.Board, dealt...)
+	p.street = street
+	return nil
+}
+
+// event parses a line in the betting or showdown sections. Lines that are
+// not about the hand, such as chat and players joining the table, are
+// skipped.
+func (p *parser) event(text string) error {
+	if rest, ok := strings.CutPrefix(text, "Dealt to "); ok {
+		open := strings.LastIndex(rest, " [")
+		if open < 0 || !strings.HasSuffix(rest, "]") {
+			return p.errorf("invalid hole cards line %q", text)
+		}
+		return p.hole(rest[:open], rest[open+2:len(rest)-1])
+	}
+	if rest, ok := strings.CutPrefix(text, "Uncalled bet ("); ok {
+		chips, name, ok := strings.Cut(rest, ") returned to ")
+		i := p.hh.seatNamed(name)
+		if !ok || i < 0 {
+			return p.errorf("invalid uncalled bet line %q", text)
+		}
+		amount, err := p.amount(chips)
+		p.hh.Seats[i].Returned += amount
+		return err
+	}
+	if i := strings.LastIndex(text, " collected "); i >= 0 {
+		if seat := p.hh.seatNamed(text[:i]); seat >= 0 {
+			chips, _, _ := strings.Cut(text[i+len(" collected "):], " ")
+			amount, err := p.amount(chips)
+			p.hh.Seats[seat].Collected += amount
+			return err
+		}
+	}
+
+	seat, verb := p.hh.playerLine(text)
+	if seat < 0 {
+		return nil
+	}
+	verb, allIn := strings.CutSuffix(verb, " and is all-in")
+	a := game.Action{Seat: seat, Street: p.street, AllIn: allIn}
+	var chips string
+	switch {
+	case verb == "folds" || strings.HasPrefix(verb, "folds "):
+		a.Type = game.Fold
+	case verb == "checks":
+		a.Type = game.Check
+	case strings.HasPrefix(verb, "calls "):
+		a.Type, chips = game.Call, verb[len("calls "):]
+	case strings.HasPrefix(verb, "bets "):
+		a.Type, chips = game.Bet, verb[len("bets "):]
+	case strings.HasPrefix(verb, "raises "):
+		_, to, ok := strings.Cut(verb, " to ")
+		if !ok {
+			return p.errorf("invalid raise %q", text)
+		}
+		a.Type, chips = game.Raise, to
+	case strings.HasPrefix(verb, "posts small blind "):
+		a.Type, chips = game.PostSmallBlind, verb[len("posts small blind "):]
+	case strings.HasPrefix(verb, "posts big blind "):
+		a.Type, chips = game.PostBigBlind, verb[len("posts big blind "):]
+	case strings.HasPrefix(verb, "posts the ante "):
+		a.Type, chips = game.PostAnte, verb[len("posts the ante "):]
+	case strings.HasPrefix(verb, "posts small & big blinds "):
+		// The small blind part is dead money, recorded like an ante
+		amount, err := p.amount(verb[len("posts small & big blinds "):])
+		if err != nil {
+			return err
+		}
+		dead := min(p.hh.SmallBlind, amount)
+		p.hh.Actions = append(p.hh.Actions,
+			game.Action{Seat: seat, Type: game.PostAnte, Amount: dead, Street: game.Preflop},
+			game.Action{Seat: seat, Type: game.PostBigBlind, Amount: amount - dead, Street: game.Preflop, AllIn: allIn})
+		return nil
+	case strings.HasPrefix(verb, "shows ["):
+		cards, _, ok := strings.Cut(verb[len("shows ["):], "]")
+		if !ok {
+			return p.errorf("invalid shown cards %q", text)
+		}
+		p.hh.Seats[seat].Showed = true
+		return p.hole(p.hh.Seats[seat].Name, cards)
+	default:
+		// Mucking, sitting out and other notices
+		return nil
+	}
+
+	if chips != "" {
+		amount, err := p.amount(chips)
+		if err != nil {
+			return err
+		}
+		a.Amount = amount
+	}
+	switch {
+	case a.Type == game.PostAnte:
+		p.hh.Ante = max(p.hh.Ante, a.Amount)
+	case a.Type == game.PostSmallBlind && p.hh.fixedLimit() && !a.AllIn:
+		p.hh.SmallBlind = max(p.hh.SmallBlind, a.Amount)
+	case a.Type == game.PostBigBlind && p.hh.fixedLimit() && !a.AllIn:
+		p.hh.BigBlind = max(p.hh.BigBlind, a.Amount)
+	}
+	switch a.Type {
+	case game.PostAnte, game.PostSmallBlind, game.PostBigBlind:
+	default:
+		if !p.holeCards {
+			return p.errorf("%v before the hole cards are dealt", a.Type)
+		}
+		if p.section != bettingSection {
+			return p.errorf("%v after the betting is over", a.Type)
+		}
+	}
+	p.hh.Actions = append(p.hh.Actions, a)
+	return nil
+}
+
+// summary parses a line in the summary section.
+func (p *parser) summary(text string) error {
+	if rest, ok := strings.CutPrefix(text, "Total pot "); ok {
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return p.errorf("summary has no total pot")
+		}
+		var err error
+		if p.hh.TotalPot, err = p.amount(fields[0]); err != nil {
+			return err
+		}
+		if _, rake, ok := strings.Cut(rest, "| Rake "); ok {
+			chips, _, _ := strings.Cut(rake, " ")
+			p.hh.Rake, err = p.amount(chips)
+		}
+		return err
+	}
+	if rest, ok := strings.CutPrefix(text, "Board ["); ok {
+		board, err := p.cards(strings.TrimSuffix(rest, "]"))
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(board) != fmt.Sprint(p.hh.Board) {
+			return p.errorf("summary board %v does not match the dealt board %v", board, p.hh.Board)
+		}
+		return nil
+	}
+
+	// Seat lines may show hole cards that were mucked at the showdown
+	rest, ok := strings.CutPrefix(text, "Seat ")
+	if !ok {
+		return nil
+	}
+	number, rest, _ := strings.Cut(rest, ": ")
+	n, err := strconv.Atoi(number)
+	i := p.hh.seatNumber(n)
+	if err != nil || i < 0 {
+		return p.errorf("summary for unknown seat %q", number)
+	}
+	for _, verb := range []string{" showed [", " mucked ["} {
+		if _, cards, ok := strings.Cut(rest, verb); ok {
+			cards, _, _ = strings.Cut(cards, "]")
+			return p.hole(p.hh.Seats[i].Name, cards)
+		}
+	}
+	return nil
+}
+
+// hole records a player's hole cards, which must match any seen before.
+func (p *parser) hole(name, text string) error {
+	i := p.hh.seatNamed(name)
+	if i < 0 {
+		return p.errorf("unknown player %q", name)
+	}
+	cards, err := p.cards(text)
+	if err != nil {
+		return err
+	}
+	seat := &p.hh.Seats[i]
+	if seat.Hole != nil {
+		if fmt.Sprint(cards) != fmt.Sprint(seat.Hole) {
+			return p.errorf("%s shows %v but was dealt %v", name, cards, seat.Hole)
+		}
+		return nil
+	}
+	if err := p.see(cards); err != nil {
+		return err
+	}
+	seat.Hole = cards
+	return nil
+}
+
+// see marks cards as dealt, failing if one was already seen.
+func (p *parser) see(cards []poker.Card) error {
+	for _, c := range cards {
+		if line, ok := p.dealt[c]; ok {
+			return p.errorf("card %v already dealt on line %d", c, line)
+		}
+		p.dealt[c] = p.line
+	}
+	return nil
+}
+
+// cards parses space-separated cards with poker.ParseCard.
+func (p *parser) cards(text string) ([]poker.Card, error) {
+	fields := strings.Fields(text)
+	cards := make([]poker.Card, len(fields))
+	for i, f := range fields {
+		c, err := poker.ParseCard(f)
+		if err != nil || c.IsJoker() {
+			return nil, p.errorf("invalid card %q", f)
+		}
+		cards[i] = c
+	}
+	return cards, nil
+}
+
+// amount parses a chip amount such as "1500", "$2" or "€0.25". Amounts
+// with a currency symbol or a decimal point are in cents.
+func (p *parser) amount(text string) (int, error) {
+	s := strings.ReplaceAll(text, ",", "")
+	cents := false
+	for _, symbol := range []string{"$", "€", "£"} {
+		if rest, ok := strings.CutPrefix(s, symbol); ok {
+			s, cents = rest, true
+		}
+	}
+	whole, frac, decimal := strings.Cut(s, ".")
+	if !isDigits(whole) || (decimal && (!isDigits(frac) || len(frac) > 2)) {
+		return 0, p.errorf("invalid amount %q", text)
+	}
+	n, err := strconv.Atoi(whole)
+	if err != nil {
+		return 0, p.errorf("invalid amount %q", text)
+	}
+	if !cents && !decimal {
+		return n, nil
+	}
+	c, _ := strconv.Atoi((frac + "00")[:2])
+	return n*100 + c, nil
+}
+
+// isBuyIn reports whether text is empty or a tournament buy-in, such as
+// "$1.40+$0.10 USD", "125+25" or "Freeroll".
+func isBuyIn(text string) bool {
+	for _, field := range strings.Fields(text) {
+		if field == "Freeroll" || (len(field) == 3 && strings.Trim(field, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == "") {
+			continue
+		}
+		parts := strings.Split(field, "+")
+		if len(parts) < 2 {
+			return false
+		}
+		for _, part := range parts {
+			whole, frac, decimal := strings.Cut(strings.TrimLeft(part, "$€£"), ".")
+			if !isDigits(whole) || (decimal && !isDigits(frac)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// seatNumber returns the index of the seat with a seat number, or -1.
+func (hh *HandHistory) seatNumber(n int) int {
+	for i, s := range hh.Seats {
+		if s.Number == n {
+			return i
+		}
+	}
+	return -1
+}
+
+// seatNamed returns the index of the seat with a player name, or -1.
+func (hh *HandHistory) seatNamed(name string) int {
+	for i, s := range hh.Seats {
+		if s.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// playerLine splits a line such as "Alice: calls 20" into the seat of the
+// player and the rest. Since names may contain ": ", the longest matching
+// name wins. Returns -1 if the line is not about a seated player.
+func (hh *HandHistory) playerLine(text string) (int, string) {
+	best, rest := -1, ""
+	for i, s := range hh.Seats {
+		if after, ok := strings.CutPrefix(text, s.Name+": "); ok && (best < 0 || len(s.Name) > len(hh.Seats[best].Name)) {
+			best, rest = i, after
+		}
+	}
+	return best, rest
+}
@@ -0,0 +1,299 @@
+package history
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// cashHand is a cash game hand that goes to a showdown.
+const cashHand = `PokerStars Hand #245123456789: Hold'em No Limit ($0.05/$0.10 USD) - 2024/03/15 20:41:12 ET
+Table 'Acamar III' 6-max Seat #2 is the button
+Seat 1: Alice ($10 in chips)
+Seat 2: Bob ($12.35 in chips)
+Seat 3: Carol ($8.20 in chips)
+Seat 5: Dave ($10 in chips) is sitting out
+Carol: posts small blind $0.05
+Alice: posts big blind $0.10
+*** HOLE CARDS ***
+Dealt to Bob [Ah Kd]
+Bob: raises $0.20 to $0.30
+Carol: calls $0.25
+Alice: folds
+*** FLOP *** [Kh 7c 2d]
+Carol: checks
+Bob: bets $0.45
+Carol: calls $0.45
+Alice said, "nh"
+*** TURN *** [Kh 7c 2d] [9s]
+Carol: bets $1.20
+Bob: raises $2.40 to $3.60
+Carol: calls $2.40
+*** RIVER *** [Kh 7c 2d 9s] [3h]
+Carol: checks
+Bob: checks
+*** SHOW DOWN ***
+Carol: shows [Kc Qc] (a pair of Kings)
+Bob: shows [Ah Kd] (a pair of Kings)
+Bob collected $8.37 from pot
+*** SUMMARY ***
+Total pot $8.80 | Rake $0.43
+Board [Kh 7c 2d 9s 3h]
+Seat 1: Alice (big blind) folded before Flop
+Seat 2: Bob (button) showed [Ah Kd] and won ($8.37) with a pair of Kings
+Seat 3: Carol (small blind) showed [Kc Qc] and lost with a pair of Kings
+Seat 5: Dave is sitting out`
+
+// tournamentHand is a tournament hand with antes won without a showdown.
+const tournamentHand = `PokerStars Hand #245123456790: Tournament #3712345678, $1.40+$0.10 USD Hold'em No Limit - Level IV (50/100) - 2024/03/15 21:02:44 CET [2024/03/15 16:02:44 ET]
+Table '3712345678 12' 9-max Seat #1 is the button
+Seat 1: Alice (2500 in chips)
+Seat 2: Bob (1800 in chips, $0.50 bounty)
+Seat 3: Carol (3100 in chips) out of hand (moved from another table into small blind)
+Alice: posts the ante 10
+Bob: posts the ante 10
+Carol: posts the ante 10
+Bob: posts small blind 50
+Carol: posts big blind 100
+*** HOLE CARDS ***
+Dealt to Alice [Tc Ts]
+Alice: raises 150 to 250
+Bob: folds
+Carol: folds
+Uncalled bet (150) returned to Alice
+Alice collected 280 from pot
+Alice: doesn't show hand
+*** SUMMARY ***
+Total pot 280 | Rake 0
+Seat 1: Alice (button) collected (280)
+Seat 2: Bob (small blind) folded before Flop
+Seat 3: Carol (big blind) folded before Flop`
+
+// limitHand is a fixed limit hand, where the header gives the bet sizes
+// rather than the blinds.
+const limitHand = `PokerStars Hand #245123456791: Hold'em Limit ($0.10/$0.20 USD) - 2024/03/15 21:10:05 ET
+Table 'Kleopatra' 6-max Seat #1 is the button
+Seat 1: Alice ($4 in chips)
+Seat 2: Bob ($5.20 in chips)
+Seat 3: Carol ($3.90 in chips)
+Bob: posts small blind $0.05
+Carol: posts big blind $0.10
+*** HOLE CARDS ***
+Dealt to Alice [Js Jd]
+Alice: raises $0.10 to $0.20
+Bob: folds
+Carol: calls $0.10
+*** FLOP *** [8h 4c 2s]
+Carol: checks
+Alice: bets $0.10
+Carol: calls $0.10
+*** TURN *** [8h 4c 2s] [Qd]
+Carol: checks
+Alice: bets $0.20
+Carol: folds
+Uncalled bet ($0.20) returned to Alice
+Alice collected $0.65 from pot
+*** SUMMARY ***
+Total pot $0.65 | Rake $0
+Board [8h 4c 2s Qd]
+Seat 1: Alice (button) collected ($0.65)
+Seat 2: Bob (small blind) folded before Flop
+Seat 3: Carol (big blind) folded on the Turn`
+
+// cards parses card notation, failing the test on error.
+func cards(t *testing.T, notations ...string) []poker.Card {
+	t.Helper()
+	parsed := make([]poker.Card, len(notations))
+	for i, s := range notations {
+		card, err := poker.ParseCard(s)
+		if err != nil {
+			t.Fatalf("ParseCard(%q): %v", s, err)
+		}
+		parsed[i] = card
+	}
+	return parsed
+}
+
+// readHand parses a single hand, failing the test on error.
+func readHand(t *testing.T, text string) *HandHistory {
+	t.Helper()
+	hh, err := NewReader(strings.NewReader(text)).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	return hh
+}
+
+// TestReadCashHand verifies every part of a parsed cash game hand.
+func TestReadCashHand(t *testing.T) {
+	hh := readHand(t, cashHand)
+
+	want := &HandHistory{
+		ID:         "245123456789",
+		Game:       "Hold'em No Limit",
+		Currency:   "USD",
+		SmallBlind: 5,
+		BigBlind:   10,
+		Time:       time.Date(2024, 3, 15, 20, 41, 12, 0, time.UTC),
+		TimeZone:   "ET",
+		Table:      "Acamar III",
+		MaxSeats:   6,
+		Button:     1,
+		Seats: []Seat{
+			{Number: 1, Name: "Alice", Stack: 1000},
+			{Number: 2, Name: "Bob", Stack: 1235, Hole: cards(t, "Ah", "Kd"), Showed: true, Collected: 837},
+			{Number: 3, Name: "Carol", Stack: 820, Hole: cards(t, "Kc", "Qc"), Showed: true},
+			{Number: 5, Name: "Dave", Stack: 1000, SittingOut: true},
+		},
+		Actions: []game.Action{
+			{Seat: 2, Type: game.PostSmallBlind, Amount: 5},
+			{Seat: 0, Type: game.PostBigBlind, Amount: 10},
+			{Seat: 1, Type: game.Raise, Amount: 30},
+			{Seat: 2, Type: game.Call, Amount: 25},
+			{Seat: 0, Type: game.Fold},
+			{Seat: 2, Type: game.Check, Street: game.Flop},
+			{Seat: 1, Type: game.Bet, Amount: 45, Street: game.Flop},
+			{Seat: 2, Type: game.Call, Amount: 45, Street: game.Flop},
+			{Seat: 2, Type: game.Bet, Amount: 120, Street: game.Turn},
+			{Seat: 1, Type: game.Raise, Amount: 360, Street: game.Turn},
+			{Seat: 2, Type: game.Call, Amount: 240, Street: game.Turn},
+			{Seat: 2, Type: game.Check, Street: game.River},
+			{Seat: 1, Type: game.Check, Street: game.River},
+		},
+		Board:    cards(t, "Kh", "7c", "2d", "9s", "3h"),
+		TotalPot: 880,
+		Rake:     43,
+	}
+	if !reflect.DeepEqual(hh, want) {
+		t.Errorf("Read() = %+v\nwant %+v", hh, want)
+	}
+}
+
+// TestReadTournamentHand verifies antes, chip amounts, the tournament
+// header and an uncalled bet.
+func TestReadTournamentHand(t *testing.T) {
+	hh := readHand(t, tournamentHand)
+
+	if hh.Tournament != "3712345678" || hh.Currency != "" {
+		t.Errorf("Tournament = %q, Currency = %q", hh.Tournament, hh.Currency)
+	}
+	if hh.SmallBlind != 50 || hh.BigBlind != 100 || hh.Ante != 10 {
+		t.Errorf("blinds %d/%d ante %d, want 50/100 ante 10", hh.SmallBlind, hh.BigBlind, hh.Ante)
+	}
+	if hh.TimeZone != "CET" || hh.Button != 0 || hh.MaxSeats != 9 {
+		t.Errorf("TimeZone %q, Button %d, MaxSeats %d", hh.TimeZone, hh.Button, hh.MaxSeats)
+	}
+	if hh.Seats[1].Stack != 1800 || hh.Seats[2].Stack != 3100 {
+		t.Errorf("stacks %d, %d, want 1800, 3100", hh.Seats[1].Stack, hh.Seats[2].Stack)
+	}
+	if alice := hh.Seats[0]; alice.Returned != 150 || alice.Collected != 280 || alice.Showed {
+		t.Errorf("Alice = %+v", alice)
+	}
+	if len(hh.Board) != 0 || len(hh.Actions) != 8 {
+		t.Errorf("Board = %v, %d actions", hh.Board, len(hh.Actions))
+	}
+
+	variant := strings.Replace(tournamentHand, "USD Hold'em", "USD 6+ Hold'em", 1)
+	if _, err := NewReader(strings.NewReader(variant)).Read(); err == nil || !strings.Contains(err.Error(), "unsupported game") {
+		t.Errorf("Read() of a 6+ Hold'em tournament error = %v, want unsupported game", err)
+	}
+}
+
+// TestReadLimitHand verifies that a fixed limit header gives the bet sizes
+// and the blinds come from the posts.
+func TestReadLimitHand(t *testing.T) {
+	hh := readHand(t, limitHand)
+
+	if hh.Game != "Hold'em Limit" || hh.SmallBet != 10 || hh.BigBet != 20 {
+		t.Errorf("Game %q, bets %d/%d, want Hold'em Limit 10/20", hh.Game, hh.SmallBet, hh.BigBet)
+	}
+	if hh.SmallBlind != 5 || hh.BigBlind != 10 {
+		t.Errorf("blinds %d/%d, want 5/10", hh.SmallBlind, hh.BigBlind)
+	}
+	if err := hh.Verify(); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	cash := readHand(t, cashHand)
+	if cash.SmallBet != 0 || cash.BigBet != 0 {
+		t.Errorf("no limit bets %d/%d, want 0/0", cash.SmallBet, cash.BigBet)
+	}
+}
+
+// TestReaderReadAll verifies reading several hands separated by blank
+// lines, and io.EOF at the end.
+func TestReaderReadAll(t *testing.T) {
+	text := "\ufeff" + cashHand + "\n\n\n" + tournamentHand + "\n\n"
+	hands, err := NewReader(strings.NewReader(text)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(hands) != 2 || hands[0].ID != "245123456789" || hands[1].ID != "245123456790" {
+		t.Fatalf("ReadAll() read %d hands", len(hands))
+	}
+
+	r := NewReader(strings.NewReader("\n\n"))
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read() on empty input = %v, want io.EOF", err)
+	}
+}
+
+// TestReadErrors verifies that malformed hands are rejected with the line
+// number of the problem.
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		wantErr string
+	}{
+		{"not a header", "PokerStars Hand #245123456789:", "Full Tilt Hand #1:", "line 1: not a PokerStars hand header"},
+		{"unsupported game", "Hold'em No Limit", "7 Card Stud Limit", "line 1: hand 245123456789: unsupported game"},
+		{"unsupported Omaha variant", "Hold'em No Limit", "5 Card Omaha Pot Limit", "line 1: hand 245123456789: unsupported game"},
+		{"unsupported hold'em variant", "Hold'em No Limit", "6+ Hold'em No Limit", "line 1: hand 245123456789: unsupported game"},
+		{"bad date", "2024/03/15 20:41:12", "yesterday", "line 1: hand 245123456789: invalid date"},
+		{"bad table", "Table 'Acamar III' 6-max Seat #2 is the button", "Table Acamar", "line 2:"},
+		{"bad seat", "Seat 2: Bob ($12.35 in chips)", "Seat 2: Bob", "line 4: invalid seat line"},
+		{"bad card", "Dealt to Bob [Ah Kd]", "Dealt to Bob [Ah Xd]", `line 10: invalid card "Xd"`},
+		{"bad amount", "raises $0.20 to $0.30", "raises $0.20 to $0.3x", `line 11: invalid amount "$0.3x"`},
+		{"card dealt twice", "*** FLOP *** [Kh 7c 2d]", "*** FLOP *** [Kh 7c Kd]", "line 14: card Kd already dealt on line 10"},
+		{"wrong flop size", "*** FLOP *** [Kh 7c 2d]", "*** FLOP *** [Kh 7c]", "line 14: Flop has 2 cards, want 3"},
+		{"shown cards differ", "Bob: shows [Ah Kd]", "Bob: shows [Ah Ks]", "line 28: Bob shows"},
+		{"action after betting", "Bob collected $8.37 from pot", "Bob: bets $1", "line 29: Bet after the betting is over"},
+		{"run twice", "*** RIVER ***", "*** FIRST RIVER ***", `line 23: unsupported section "FIRST RIVER"`},
+		{"board mismatch", "Board [Kh 7c 2d 9s 3h]", "Board [Kh 7c 2d 9s 4h]", "line 32: summary board"},
+		{"no summary", "*** SUMMARY ***", "*** SHOW DOWN ***", "line 36:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := strings.Replace(cashHand, tt.old, tt.new, 1)
+			if text == cashHand {
+				t.Fatalf("%q not found in the hand", tt.old)
+			}
+			_, err := NewReader(strings.NewReader(text)).Read()
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want prefix %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestReadErrorLineInFile verifies that line numbers count from the start
+// of the input, not the hand.
+func TestReadErrorLineInFile(t *testing.T) {
+	text := tournamentHand + "\n\n" + strings.Replace(cashHand, "[Ah Kd]", "[Ah]]", 1)
+	r := NewReader(strings.NewReader(text))
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("first hand: %v", err)
+	}
+	_, err := r.Read()
+	if err == nil || !strings.HasPrefix(err.Error(), "line 34:") {
+		t.Errorf("Read() error = %v, want line 34", err)
+	}
+}
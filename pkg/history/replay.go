@@ -0,0 +1,122 @@
+package history
+
+import (
+	"slices"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// Replay steps through a hand one action at a time, tracking the chips and
+// cards on the table as they were after each action.
+type Replay struct {
+	hh     *HandHistory
+	next   int         // Index of the next action to play
+	street game.Street // Street of the last action played
+	pot    int
+	bets   []int
+	stacks []int
+	err    error
+}
+
+// NewReplay returns a Replay positioned before the hand's first action,
+// with every player holding their starting stack.
+func NewReplay(hh *HandHistory) *Replay {
+	r := &Replay{hh: hh, bets: make([]int, len(hh.Seats)), stacks: make([]int, len(hh.Seats))}
+	for i, s := range hh.Seats {
+		r.stacks[i] = s.Stack
+	}
+	return r
+}
+
+// Next plays the next action and reports whether there was one. Once every
+// action has been played, Next deals out the rest of the board, returns
+// uncalled bets and pays the pots, then reports false. It also reports
+// false, leaving the hand unsettled, at an action naming a seat not in
+// Seats; Err then returns the error.
+func (r *Replay) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	if r.next >= len(r.hh.Actions) {
+		if r.street != game.Complete {
+			r.street = game.Complete
+			for i, s := range r.hh.Seats {
+				r.stacks[i] += s.Returned + s.Collected
+			}
+			r.pot = 0
+			clear(r.bets)
+		}
+		return false
+	}
+
+	a := r.hh.Actions[r.next]
+	if a.Seat < 0 || a.Seat >= len(r.stacks) {
+		r.err = r.hh.checkSeats()
+		return false
+	}
+	r.next++
+	if a.Street != r.street {
+		clear(r.bets)
+		r.street = a.Street
+	}
+	chips := a.Amount
+	switch a.Type {
+	case game.Fold, game.Check:
+		chips = 0
+	case game.Bet, game.Raise:
+		chips = a.Amount - r.bets[a.Seat]
+	}
+	if a.Type != game.PostAnte {
+		r.bets[a.Seat] += chips
+	}
+	r.stacks[a.Seat] -= chips
+	r.pot += chips
+	return true
+}
+
+// Err returns the error that stopped the replay, or nil if every action
+// could be played.
+func (r *Replay) Err() error {
+	return r.err
+}
+
+// Action returns the last action played, or false before the first one.
+func (r *Replay) Action() (game.Action, bool) {
+	if r.next == 0 {
+		return game.Action{}, false
+	}
+	return r.hh.Actions[r.next-1], true
+}
+
+// Street returns the street of the last action played, or game.Complete
+// once the hand has been settled.
+func (r *Replay) Street() game.Street {
+	return r.street
+}
+
+// Pot returns the chips put in so far, bets on the current street
+// included; 0 once the pots are paid.
+func (r *Replay) Pot() int {
+	return r.pot
+}
+
+// Bets returns the chips each seat has put in on the current street.
+func (r *Replay) Bets() []int {
+	return slices.Clone(r.bets)
+}
+
+// Stacks returns the chips each seat has behind.
+func (r *Replay) Stacks() []int {
+	return slices.Clone(r.stacks)
+}
+
+// Board returns the board cards out on the current street: none preflop,
+// three on the flop and so on, and the whole board once the hand is
+// settled.
+func (r *Replay) Board() []poker.Card {
+	if r.street == game.Complete {
+		return slices.Clone(r.hh.Board)
+	}
+	return slices.Clone(r.hh.Board[:min(boardSize[r.street], len(r.hh.Board))])
+}
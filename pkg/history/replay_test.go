@@ -0,0 +1,112 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+)
+
+// TestReplay verifies the pot, stacks and board after selected actions.
+func TestReplay(t *testing.T) {
+	type state struct {
+		pot    int
+		stacks []int
+		board  int
+	}
+	tests := []struct {
+		name   string
+		text   string
+		states map[int]state // Keyed by actions played
+		final  []int
+	}{
+		{
+			name: "cash game",
+			text: cashHand,
+			states: map[int]state{
+				0:  {0, []int{1000, 1235, 820, 1000}, 0},
+				2:  {15, []int{990, 1235, 815, 1000}, 0},
+				3:  {45, []int{990, 1205, 815, 1000}, 0},
+				6:  {70, []int{990, 1205, 790, 1000}, 3},
+				10: {640, []int{990, 800, 625, 1000}, 4},
+				13: {880, []int{990, 800, 385, 1000}, 5},
+			},
+			final: []int{990, 1637, 385, 1000},
+		},
+		{
+			name: "antes and an uncalled bet",
+			text: tournamentHand,
+			states: map[int]state{
+				5: {180, []int{2490, 1740, 2990}, 0},
+				6: {430, []int{2240, 1740, 2990}, 0},
+			},
+			final: []int{2670, 1740, 2990},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hh := readHand(t, tt.text)
+			r := NewReplay(hh)
+			for played := 0; ; played++ {
+				if want, ok := tt.states[played]; ok {
+					if r.Pot() != want.pot || !reflect.DeepEqual(r.Stacks(), want.stacks) || len(r.Board()) != want.board {
+						t.Errorf("after %d actions: pot %d, stacks %v, board %v; want %d, %v, %d cards",
+							played, r.Pot(), r.Stacks(), r.Board(), want.pot, want.stacks, want.board)
+					}
+				}
+				if !r.Next() {
+					break
+				}
+				if a, ok := r.Action(); !ok || a != hh.Actions[played] {
+					t.Errorf("Action() = %+v, %v, want %+v", a, ok, hh.Actions[played])
+				}
+			}
+
+			if r.Street() != game.Complete || r.Pot() != 0 || len(r.Board()) != len(hh.Board) {
+				t.Errorf("after the hand: Street %v, Pot %d, Board %v", r.Street(), r.Pot(), r.Board())
+			}
+			if got := r.Stacks(); !reflect.DeepEqual(got, tt.final) {
+				t.Errorf("final stacks = %v, want %v", got, tt.final)
+			}
+			if r.Next() {
+				t.Error("Next() after the hand should report false")
+			}
+		})
+	}
+}
+
+// TestReplayBets verifies street bets, with antes kept out of them.
+func TestReplayBets(t *testing.T) {
+	r := NewReplay(readHand(t, tournamentHand))
+	if _, ok := r.Action(); ok {
+		t.Error("Action() before the first action should report false")
+	}
+	for range 6 {
+		r.Next()
+	}
+	if got, want := r.Bets(), []int{250, 50, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Bets() = %v, want %v", got, want)
+	}
+}
+
+// TestReplayBadSeat verifies that the replay stops with an error at an
+// action by a seat not in Seats.
+func TestReplayBadSeat(t *testing.T) {
+	hh := readHand(t, tournamentHand)
+	hh.Actions[5].Seat = 3
+	r := NewReplay(hh)
+	played := 0
+	for r.Next() {
+		played++
+	}
+	if played != 5 {
+		t.Errorf("played %d actions, want 5", played)
+	}
+	if want := "hand 245123456790: action 6 is by seat index 3, but there are 3 seats"; r.Err() == nil || r.Err().Error() != want {
+		t.Errorf("Err() = %v, want %q", r.Err(), want)
+	}
+	if r.Street() == game.Complete || r.Next() {
+		t.Error("a stopped replay should stay unsettled and report false")
+	}
+}
@@ -0,0 +1,374 @@
+package history
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// boardSize is the number of board cards out on each street.
+var boardSize = map[game.Street]int{game.Preflop: 0, game.Flop: 3, game.Turn: 4, game.River: 5}
+
+// WriteText writes a hand in the PokerStars text format that Reader reads.
+// Every known hole card is written as dealt, and shown hands are described
+// with poker.Hand.Describe rather than the site's wording. A hand without
+// an ID is written as hand #0, and fixed limit games are written with their
+// bet sizes as the stakes.
+//
+// Returns an error if an action names a seat not in Seats, is on a street
+// the board does not reach, or the actions are not in street order.
+func WriteText(w io.Writer, hh *HandHistory) error {
+	if err := hh.checkSeats(); err != nil {
+		return err
+	}
+	var b strings.Builder
+	amount := func(n int) string { return formatAmount(hh.Currency, n) }
+
+	name := hh.Game
+	if hh.Tournament != "" {
+		name = "Tournament #" + hh.Tournament + ", " + name
+	}
+	stakes := amount(hh.SmallBlind) + "/" + amount(hh.BigBlind)
+	if hh.fixedLimit() {
+		stakes = amount(hh.SmallBet) + "/" + amount(hh.BigBet)
+	}
+	if hh.Currency != "" {
+		stakes += " " + hh.Currency
+	}
+	date := hh.Time.Format("2006/01/02 15:04:05")
+	if hh.TimeZone != "" {
+		date += " " + hh.TimeZone
+	}
+	fmt.Fprintf(&b, "PokerStars Hand #%s: %s (%s) - %s\n", cmp.Or(hh.ID, "0"), name, stakes, date)
+
+	fmt.Fprintf(&b, "Table '%s' ", hh.Table)
+	if hh.MaxSeats > 0 {
+		fmt.Fprintf(&b, "%d-max ", hh.MaxSeats)
+	}
+	button := 0
+	if hh.Button >= 0 && hh.Button < len(hh.Seats) {
+		button = hh.Seats[hh.Button].Number
+	}
+	fmt.Fprintf(&b, "Seat #%d is the button\n", button)
+	for _, s := range hh.Seats {
+		fmt.Fprintf(&b, "Seat %d: %s (%s in chips)", s.Number, s.Name, amount(s.Stack))
+		if s.SittingOut {
+			b.WriteString(" is sitting out")
+		}
+		b.WriteString("\n")
+	}
+
+	// Forced bets come before the hole cards, the rest street by street
+	next := 0
+	for next < len(hh.Actions) && isForced(hh.Actions[next].Type) {
+		writeAction(&b, hh, hh.Actions[next], 0, amount)
+		next++
+	}
+	b.WriteString("*** HOLE CARDS ***\n")
+	for _, s := range hh.Seats {
+		if s.Hole != nil {
+			fmt.Fprintf(&b, "Dealt to %s %s\n", s.Name, cardList(s.Hole))
+		}
+	}
+	// Raises are written as the increase over the largest bet so far
+	current := 0
+	for _, a := range hh.Actions[:next] {
+		if a.Type != game.PostAnte {
+			current = max(current, a.Amount)
+		}
+	}
+	for street := game.Preflop; street <= game.River; street++ {
+		if street > game.Preflop {
+			n := boardSize[street]
+			if len(hh.Board) < n {
+				break
+			}
+			fmt.Fprintf(&b, "*** %s ***", strings.ToUpper(street.String()))
+			if street > game.Flop {
+				fmt.Fprintf(&b, " %s", cardList(hh.Board[:boardSize[street-1]]))
+			}
+			fmt.Fprintf(&b, " %s\n", cardList(hh.Board[boardSize[street-1]:n]))
+			current = 0
+		}
+		for ; next < len(hh.Actions) && hh.Actions[next].Street == street; next++ {
+			a := hh.Actions[next]
+			writeAction(&b, hh, a, current, amount)
+			if a.Type == game.Bet || a.Type == game.Raise {
+				current = a.Amount
+			}
+		}
+	}
+	if next < len(hh.Actions) {
+		a := hh.Actions[next]
+		return fmt.Errorf("hand %s: %v by %s on the %v does not follow the board %s",
+			cmp.Or(hh.ID, "0"), a.Type, hh.Seats[a.Seat].Name, a.Street, cardList(hh.Board))
+	}
+	for _, s := range hh.Seats {
+		if s.Returned > 0 {
+			fmt.Fprintf(&b, "Uncalled bet (%s) returned to %s\n", amount(s.Returned), s.Name)
+		}
+	}
+
+	// Descriptions stay empty, and are left out, for hands under 5 cards
+	descriptions := make([]string, len(hh.Seats))
+	showdown := false
+	for i := range hh.Seats {
+		s := &hh.Seats[i]
+		if !s.Showed {
+			continue
+		}
+		showdown = true
+		if hand := cmp.Or(s.Hand, hh.bestHand(s)); hand != nil {
+			descriptions[i] = hand.Describe()
+		}
+	}
+	if showdown {
+		b.WriteString("*** SHOW DOWN ***\n")
+		for i, s := range hh.Seats {
+			if s.Showed {
+				fmt.Fprintf(&b, "%s: shows %s%s\n", s.Name, cardList(s.Hole), describedAs(" (%s)", descriptions[i]))
+			}
+		}
+	}
+	for _, s := range hh.Seats {
+		if s.Collected > 0 {
+			fmt.Fprintf(&b, "%s collected %s from pot\n", s.Name, amount(s.Collected))
+		}
+	}
+
+	b.WriteString("*** SUMMARY ***\n")
+	fmt.Fprintf(&b, "Total pot %s | Rake %s\n", amount(hh.TotalPot), amount(hh.Rake))
+	if len(hh.Board) > 0 {
+		fmt.Fprintf(&b, "Board %s\n", cardList(hh.Board))
+	}
+	folded := make([]game.Street, len(hh.Seats))
+	for i := range folded {
+		folded[i] = game.Complete
+	}
+	for _, a := range hh.Actions {
+		if a.Type == game.Fold {
+			folded[a.Seat] = a.Street
+		}
+	}
+	for i, s := range hh.Seats {
+		fmt.Fprintf(&b, "Seat %d: %s ", s.Number, s.Name)
+		if i == hh.Button {
+			b.WriteString("(button) ")
+		}
+		switch {
+		case s.SittingOut:
+			b.WriteString("is sitting out")
+		case folded[i] == game.Preflop:
+			b.WriteString("folded before Flop")
+		case folded[i] != game.Complete:
+			fmt.Fprintf(&b, "folded on the %v", folded[i])
+		case s.Showed && s.Collected > 0:
+			fmt.Fprintf(&b, "showed %s and won (%s)%s", cardList(s.Hole), amount(s.Collected), describedAs(" with %s", descriptions[i]))
+		case s.Showed:
+			fmt.Fprintf(&b, "showed %s and lost%s", cardList(s.Hole), describedAs(" with %s", descriptions[i]))
+		case s.Collected > 0:
+			fmt.Fprintf(&b, "collected (%s)", amount(s.Collected))
+		default:
+			b.WriteString("mucked")
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// describedAs formats a hand description, or returns "" if there is none.
+func describedAs(format, description string) string {
+	if description == "" {
+		return ""
+	}
+	return fmt.Sprintf(format, description)
+}
+
+// isForced reports whether an action is a forced bet.
+func isForced(t game.ActionType) bool {
+	return t == game.PostAnte || t == game.PostSmallBlind || t == game.PostBigBlind
+}
+
+// writeAction writes one action line. current is the largest bet on the
+// street before the action, which a raise is written relative to.
+func writeAction(b *strings.Builder, hh *HandHistory, a game.Action, current int, amount func(int) string) {
+	fmt.Fprintf(b, "%s: ", hh.Seats[a.Seat].Name)
+	switch a.Type {
+	case game.Fold:
+		b.WriteString("folds")
+	case game.Check:
+		b.WriteString("checks")
+	case game.Call:
+		b.WriteString("calls " + amount(a.Amount))
+	case game.Bet:
+		b.WriteString("bets " + amount(a.Amount))
+	case game.Raise:
+		fmt.Fprintf(b, "raises %s to %s", amount(a.Amount-current), amount(a.Amount))
+	case game.PostAnte:
+		b.WriteString("posts the ante " + amount(a.Amount))
+	case game.PostSmallBlind:
+		b.WriteString("posts small blind " + amount(a.Amount))
+	case game.PostBigBlind:
+		b.WriteString("posts big blind " + amount(a.Amount))
+	}
+	if a.AllIn {
+		b.WriteString(" and is all-in")
+	}
+	b.WriteString("\n")
+}
+
+// cardList writes cards in brackets, such as "[Ah Kd]".
+func cardList(cards []poker.Card) string {
+	return "[" + strings.Join(cardNames(cards), " ") + "]"
+}
+
+// formatAmount writes an amount as chips, or in cents with a currency
+// symbol if a currency is set.
+func formatAmount(currency string, n int) string {
+	if currency == "" {
+		return fmt.Sprint(n)
+	}
+	symbol := "$"
+	switch currency {
+	case "EUR":
+		symbol = "€"
+	case "GBP":
+		symbol = "£"
+	}
+	return fmt.Sprintf("%s%d.%02d", symbol, n/100, n%100)
+}
+
+// jsonHand is the JSON form of a HandHistory.
+type jsonHand struct {
+	ID         string       `json:"id"`
+	Tournament string       `json:"tournament,omitempty"`
+	Game       string       `json:"game"`
+	Currency   string       `json:"currency,omitempty"`
+	SmallBlind int          `json:"small_blind"`
+	BigBlind   int          `json:"big_blind"`
+	SmallBet   int          `json:"small_bet,omitempty"`
+	BigBet     int          `json:"big_bet,omitempty"`
+	Ante       int          `json:"ante,omitempty"`
+	Time       string       `json:"time"`
+	TimeZone   string       `json:"time_zone,omitempty"`
+	Table      string       `json:"table"`
+	MaxSeats   int          `json:"max_seats,omitempty"`
+	Button     int          `json:"button"`
+	Seats      []jsonSeat   `json:"seats"`
+	Actions    []jsonAction `json:"actions"`
+	Board      []string     `json:"board"`
+	TotalPot   int          `json:"total_pot"`
+	Rake       int          `json:"rake"`
+}
+
+// jsonSeat is the JSON form of a Seat.
+type jsonSeat struct {
+	Seat       int      `json:"seat"`
+	Name       string   `json:"name"`
+	Stack      int      `json:"stack"`
+	SittingOut bool     `json:"sitting_out,omitempty"`
+	Hole       []string `json:"hole,omitempty"`
+	Showed     bool     `json:"showed,omitempty"`
+	Hand       string   `json:"hand,omitempty"`
+	Returned   int      `json:"returned,omitempty"`
+	Collected  int      `json:"collected,omitempty"`
+}
+
+// jsonAction is the JSON form of an action.
+type jsonAction struct {
+	Street string `json:"street"`
+	Seat   int    `json:"seat"`
+	Player string `json:"player"`
+	Action string `json:"action"`
+	Amount int    `json:"amount,omitempty"`
+	AllIn  bool   `json:"all_in,omitempty"`
+}
+
+// cardNames returns the notation of each card.
+func cardNames(cards []poker.Card) []string {
+	if cards == nil {
+		return nil
+	}
+	names := make([]string, len(cards))
+	for i, c := range cards {
+		names[i] = c.String()
+	}
+	return names
+}
+
+// WriteJSON writes a hand as indented JSON. Cards are written in card
+// notation, seats by their printed seat number, actions by name, and shown
+// hands with their poker.Hand.Describe description. Amounts are as in the
+// HandHistory.
+// Returns an error if an action names a seat not in Seats.
+func WriteJSON(w io.Writer, hh *HandHistory) error {
+	if err := hh.checkSeats(); err != nil {
+		return err
+	}
+	out := jsonHand{
+		ID:         hh.ID,
+		Tournament: hh.Tournament,
+		Game:       hh.Game,
+		Currency:   hh.Currency,
+		SmallBlind: hh.SmallBlind,
+		BigBlind:   hh.BigBlind,
+		SmallBet:   hh.SmallBet,
+		BigBet:     hh.BigBet,
+		Ante:       hh.Ante,
+		Time:       hh.Time.Format("2006-01-02T15:04:05"),
+		TimeZone:   hh.TimeZone,
+		Table:      hh.Table,
+		MaxSeats:   hh.MaxSeats,
+		Seats:      []jsonSeat{},
+		Actions:    []jsonAction{},
+		Board:      cardNames(hh.Board),
+		TotalPot:   hh.TotalPot,
+		Rake:       hh.Rake,
+	}
+	if out.Board == nil {
+		out.Board = []string{}
+	}
+	if hh.Button >= 0 && hh.Button < len(hh.Seats) {
+		out.Button = hh.Seats[hh.Button].Number
+	}
+	for i := range hh.Seats {
+		s := &hh.Seats[i]
+		seat := jsonSeat{
+			Seat:       s.Number,
+			Name:       s.Name,
+			Stack:      s.Stack,
+			SittingOut: s.SittingOut,
+			Hole:       cardNames(s.Hole),
+			Showed:     s.Showed,
+			Returned:   s.Returned,
+			Collected:  s.Collected,
+		}
+		if s.Showed {
+			if hand := cmp.Or(s.Hand, hh.bestHand(s)); hand != nil {
+				seat.Hand = hand.Describe()
+			}
+		}
+		out.Seats = append(out.Seats, seat)
+	}
+	for _, a := range hh.Actions {
+		out.Actions = append(out.Actions, jsonAction{
+			Street: a.Street.String(),
+			Seat:   hh.Seats[a.Seat].Number,
+			Player: hh.Seats[a.Seat].Name,
+			Action: a.Type.String(),
+			Amount: a.Amount,
+			AllIn:  a.AllIn,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,220 @@
+package history
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/game"
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// playedHand plays a three-handed no-limit hand from a seeded deck, with
+// seat 0 raising and both others calling down.
+func playedHand(t *testing.T) *game.Hand {
+	t.Helper()
+	deck := poker.NewDeck()
+	deck.Shuffle(poker.NewSeededSource(7))
+	h, err := game.NewHand(deck, []int{1000, 1000, 500}, game.Config{SmallBlind: 10, BigBlind: 20})
+	if err != nil {
+		t.Fatalf("NewHand: %v", err)
+	}
+	if err := h.Act(game.Action{Seat: 0, Type: game.Raise, Amount: 60}); err != nil {
+		t.Fatalf("Act: %v", err)
+	}
+	for h.Street != game.Complete {
+		legal := h.LegalActions()
+		a := game.Action{Seat: legal.Seat, Type: game.Check}
+		if legal.Call > 0 {
+			a.Type = game.Call
+		}
+		if err := h.Act(a); err != nil {
+			t.Fatalf("Act(%+v): %v", a, err)
+		}
+	}
+	return h
+}
+
+// TestWriteTextRoundTrip verifies that written hands read back unchanged.
+func TestWriteTextRoundTrip(t *testing.T) {
+	fromGame, err := FromGame(playedHand(t))
+	if err != nil {
+		t.Fatalf("FromGame: %v", err)
+	}
+	for i := range fromGame.Seats {
+		fromGame.Seats[i].Hand = nil
+	}
+
+	tests := []struct {
+		name string
+		hh   *HandHistory
+	}{
+		{"cash game", readHand(t, cashHand)},
+		{"tournament", readHand(t, tournamentHand)},
+		{"omaha", readHand(t, omahaHand)},
+		{"fixed limit", readHand(t, limitHand)},
+		{"game engine", fromGame},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := WriteText(&b, tt.hh); err != nil {
+				t.Fatalf("WriteText: %v", err)
+			}
+			got := readHand(t, b.String())
+			want := *tt.hh
+			want.ID = got.ID
+			if !reflect.DeepEqual(got, &want) {
+				t.Errorf("read back %+v\nwant %+v\ntext:\n%s", got, &want, b.String())
+			}
+		})
+	}
+}
+
+// TestWriteText verifies the wording of action, showdown and summary
+// lines.
+func TestWriteText(t *testing.T) {
+	var b strings.Builder
+	if err := WriteText(&b, readHand(t, cashHand)); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	text := b.String()
+
+	for _, line := range []string{
+		"PokerStars Hand #245123456789: Hold'em No Limit ($0.05/$0.10 USD) - 2024/03/15 20:41:12 ET\n",
+		"Table 'Acamar III' 6-max Seat #2 is the button\n",
+		"Seat 5: Dave ($10.00 in chips) is sitting out\n",
+		"Dealt to Bob [Ah Kd]\n",
+		"Bob: raises $0.20 to $0.30\n",
+		"Bob: raises $2.40 to $3.60\n",
+		"*** TURN *** [Kh 7c 2d] [9s]\n",
+		"Bob: shows [Ah Kd] (One Pair, Kings with Ace-Nine-Seven kickers)\n",
+		"Total pot $8.80 | Rake $0.43\n",
+		"Seat 1: Alice folded before Flop\n",
+		"Seat 2: Bob (button) showed [Ah Kd] and won ($8.37) with One Pair, Kings with Ace-Nine-Seven kickers\n",
+		"Seat 3: Carol showed [Kc Qc] and lost with One Pair, Kings with Queen-Nine-Seven kickers\n",
+	} {
+		if !strings.Contains(text, line) {
+			t.Errorf("missing line %q in:\n%s", line, text)
+		}
+	}
+}
+
+// TestWriteTextLimitHeader verifies that a fixed limit hand is written
+// with its bet sizes as the stakes.
+func TestWriteTextLimitHeader(t *testing.T) {
+	var b strings.Builder
+	if err := WriteText(&b, readHand(t, limitHand)); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	want := "PokerStars Hand #245123456791: Hold'em Limit ($0.10/$0.20 USD) - "
+	if !strings.HasPrefix(b.String(), want) {
+		t.Errorf("header %q, want prefix %q", strings.SplitN(b.String(), "\n", 2)[0], want)
+	}
+}
+
+// TestWriteTextUndescribedHand verifies that a shown hand with too few
+// cards to describe is written without a description.
+func TestWriteTextUndescribedHand(t *testing.T) {
+	hh := readHand(t, tournamentHand)
+	hh.Seats[0].Showed = true
+	var b strings.Builder
+	if err := WriteText(&b, hh); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	for _, line := range []string{
+		"Alice: shows [Tc Ts]\n",
+		"Seat 1: Alice (button) showed [Tc Ts] and won (280)\n",
+	} {
+		if !strings.Contains(b.String(), line) {
+			t.Errorf("missing line %q in:\n%s", line, b.String())
+		}
+	}
+}
+
+// TestWriteTextErrors verifies rejection of actions the board cannot be
+// written with.
+func TestWriteTextErrors(t *testing.T) {
+	short := readHand(t, cashHand)
+	short.Board = short.Board[:3]
+	unordered := readHand(t, cashHand)
+	unordered.Actions[5], unordered.Actions[8] = unordered.Actions[8], unordered.Actions[5]
+	badSeat := readHand(t, cashHand)
+	badSeat.Actions[3].Seat = 7
+	negativeSeat := readHand(t, cashHand)
+	negativeSeat.Actions[0].Seat = -1
+
+	tests := []struct {
+		name    string
+		hh      *HandHistory
+		wantErr string
+	}{
+		{"missing board cards", short, "hand 245123456789: Bet by Carol on the Turn does not follow the board [Kh 7c 2d]"},
+		{"streets out of order", unordered, "hand 245123456789: Bet by Bob on the Flop does not follow"},
+		{"seat out of range", badSeat, "hand 245123456789: action 4 is by seat index 7, but there are 4 seats"},
+		{"negative seat", negativeSeat, "hand 245123456789: action 1 is by seat index -1, but there are 4 seats"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			err := WriteText(&b, tt.hh)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("WriteText() error = %v, want prefix %q", err, tt.wantErr)
+			}
+			if b.Len() != 0 {
+				t.Errorf("WriteText() wrote %q before failing", b.String())
+			}
+		})
+	}
+}
+
+// TestWriteJSON verifies the JSON form of a hand.
+func TestWriteJSON(t *testing.T) {
+	var b strings.Builder
+	if err := WriteJSON(&b, readHand(t, cashHand)); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var got jsonHand
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatalf("Unmarshal: %v\n%s", err, b.String())
+	}
+
+	if got.ID != "245123456789" || got.Time != "2024-03-15T20:41:12" || got.Button != 2 {
+		t.Errorf("ID %q, Time %q, Button %d", got.ID, got.Time, got.Button)
+	}
+	if want := []string{"Kh", "7c", "2d", "9s", "3h"}; !reflect.DeepEqual(got.Board, want) {
+		t.Errorf("Board = %v, want %v", got.Board, want)
+	}
+	wantSeat := jsonSeat{
+		Seat: 2, Name: "Bob", Stack: 1235, Hole: []string{"Ah", "Kd"}, Showed: true,
+		Hand: "One Pair, Kings with Ace-Nine-Seven kickers", Collected: 837,
+	}
+	if !reflect.DeepEqual(got.Seats[1], wantSeat) {
+		t.Errorf("Seats[1] = %+v, want %+v", got.Seats[1], wantSeat)
+	}
+	wantAction := jsonAction{Street: "Preflop", Seat: 2, Player: "Bob", Action: "Raise", Amount: 30}
+	if !reflect.DeepEqual(got.Actions[2], wantAction) {
+		t.Errorf("Actions[2] = %+v, want %+v", got.Actions[2], wantAction)
+	}
+	if !strings.Contains(b.String(), "\n  \"id\": ") {
+		t.Errorf("output is not indented:\n%s", b.String())
+	}
+}
+
+// TestWriteJSONBadSeat verifies that an action by a seat not in Seats is
+// rejected.
+func TestWriteJSONBadSeat(t *testing.T) {
+	hh := readHand(t, cashHand)
+	hh.Actions[3].Seat = len(hh.Seats)
+	var b strings.Builder
+	err := WriteJSON(&b, hh)
+	if want := "hand 245123456789: action 4 is by seat index 4, but there are 4 seats"; err == nil || err.Error() != want {
+		t.Errorf("WriteJSON() error = %v, want %q", err, want)
+	}
+	if b.Len() != 0 {
+		t.Errorf("WriteJSON() wrote %q before failing", b.String())
+	}
+}
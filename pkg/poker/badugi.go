@@ -0,0 +1,121 @@
+package poker
+
+import (
+	"sort"
+	"strings"
+)
+
+// BadugiHand is the playing part of a Badugi hand: the largest set of cards
+// with distinct ranks and distinct suits, aces low. A 4-card hand (a badugi)
+// beats any 3-card hand, and so on; hands of the same size compare by their
+// highest card, then the next, with lower cards better.
+type BadugiHand struct {
+	Cards []Card // Playing cards from highest to lowest, aces low
+}
+
+// Size returns the number of playing cards, from 1 to 4.
+func (h *BadugiHand) Size() int {
+	return len(h.Cards)
+}
+
+// String returns the playing ranks from highest to lowest, for example
+// "8-5-3-A".
+func (h *BadugiHand) String() string {
+	if h == nil {
+		return "no hand"
+	}
+	parts := make([]string, len(h.Cards))
+	for i, card := range h.Cards {
+		parts[i] = card.Rank.String()
+	}
+	return strings.Join(parts, "-")
+}
+
+// isBadugi reports whether no two cards share a rank or a suit.
+func isBadugi(cards []Card) bool {
+	var ranks uint16
+	var suits uint8
+	for _, card := range cards {
+		if ranks&rankBit(card.Rank) != 0 || suits&(1<<uint(card.Suit)) != 0 {
+			return false
+		}
+		ranks |= rankBit(card.Rank)
+		suits |= 1 << uint(card.Suit)
+	}
+	return true
+}
+
+// newBadugiHand orders playing cards from highest to lowest, aces low.
+func newBadugiHand(cards []Card) *BadugiHand {
+	ordered := make([]Card, len(cards))
+	copy(ordered, cards)
+	sort.Slice(ordered, func(i, j int) bool {
+		return lowValue(ordered[i].Rank) > lowValue(ordered[j].Rank)
+	})
+	return &BadugiHand{Cards: ordered}
+}
+
+// EvaluateBadugi finds the best Badugi hand in exactly 4 cards by checking
+// every subset of cards with no repeated rank or suit. For example
+// Ah-2h-3d-4c plays as the 3-card hand 4-3-A, since the two hearts cannot
+// both play.
+// Returns nil if the input is not exactly 4 valid, distinct cards.
+func EvaluateBadugi(cards []Card) *BadugiHand {
+	if len(cards) != 4 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+
+	var best *BadugiHand
+	subset := make([]Card, 0, 4)
+	for mask := 1; mask < 1<<4; mask++ {
+		subset = subset[:0]
+		for i, card := range cards {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, card)
+			}
+		}
+		if !isBadugi(subset) {
+			continue
+		}
+		if hand := newBadugiHand(subset); CompareBadugiHands(hand, best) > 0 {
+			best = hand
+		}
+	}
+	return best
+}
+
+// CompareBadugiHands compares two Badugi hands and returns:
+// 1 if hand1 is better, -1 if hand2 is better, 0 if equal.
+// More playing cards win; otherwise the lower cards win, compared from the
+// highest card down. A nil hand loses to any hand.
+func CompareBadugiHands(hand1, hand2 *BadugiHand) int {
+	switch {
+	case hand1 == nil && hand2 == nil:
+		return 0
+	case hand2 == nil:
+		return 1
+	case hand1 == nil:
+		return -1
+	}
+
+	if hand1.Size() != hand2.Size() {
+		if hand1.Size() > hand2.Size() {
+			return 1
+		}
+		return -1
+	}
+
+	for i := range hand1.Cards {
+		v1, v2 := lowValue(hand1.Cards[i].Rank), lowValue(hand2.Cards[i].Rank)
+		if v1 < v2 {
+			return 1
+		}
+		if v1 > v2 {
+			return -1
+		}
+	}
+	return 0
+}
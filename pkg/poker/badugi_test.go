@@ -0,0 +1,85 @@
+package poker
+
+import (
+	"testing"
+)
+
+// TestEvaluateBadugi verifies sub-hand selection, including the tricky
+// cases where duplicate ranks and suits overlap.
+func TestEvaluateBadugi(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+		want  string
+		size  int
+	}{
+		{"four-card badugi", []string{"Ah", "2d", "3c", "4s"}, "4-3-2-A", 4},
+		{"rough badugi", []string{"Kh", "Qd", "Jc", "Ts"}, "K-Q-J-T", 4},
+		{"one suit repeated", []string{"Ah", "2h", "3d", "4c"}, "4-3-A", 3},
+		{"one rank repeated", []string{"5h", "5d", "3c", "2s"}, "5-3-2", 3},
+		{"keeps the lower of two suited cards", []string{"Kc", "2c", "3d", "4h"}, "4-3-2", 3},
+		{"pair resolved by a shared suit", []string{"7h", "7d", "3d", "Ac"}, "7-3-A", 3},
+		{"pair and suit overlap", []string{"2h", "2d", "3h", "4c"}, "4-3-2", 3},
+		{"two pairs", []string{"Ah", "Ad", "2h", "2d"}, "2-A", 2},
+		{"two suits", []string{"Ah", "2h", "3d", "4d"}, "3-A", 2},
+		{"four of a suit", []string{"Kh", "5h", "9h", "3h"}, "3", 1},
+		{"four of a kind", []string{"9h", "9d", "9c", "9s"}, "9", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := EvaluateBadugi(mustParseCards(t, tt.cards...))
+			if hand == nil {
+				t.Fatal("EvaluateBadugi returned nil")
+			}
+			if got := hand.String(); got != tt.want {
+				t.Errorf("EvaluateBadugi = %s, want %s", got, tt.want)
+			}
+			if hand.Size() != tt.size {
+				t.Errorf("Size() = %d, want %d", hand.Size(), tt.size)
+			}
+			if !isBadugi(hand.Cards) {
+				t.Errorf("playing cards %v repeat a rank or suit", hand.Cards)
+			}
+		})
+	}
+
+	invalid := [][]string{
+		{"Ah", "2d", "3c"},
+		{"Ah", "2d", "3c", "4s", "5h"},
+		{"Ah", "Ah", "3c", "4s"},
+	}
+	for _, cards := range invalid {
+		if hand := EvaluateBadugi(mustParseCards(t, cards...)); hand != nil {
+			t.Errorf("EvaluateBadugi(%v) = %v, want nil", cards, hand)
+		}
+	}
+}
+
+// TestCompareBadugiHands verifies that size comes first, then low cards.
+func TestCompareBadugiHands(t *testing.T) {
+	badugi := func(cards ...string) *BadugiHand { return EvaluateBadugi(mustParseCards(t, cards...)) }
+
+	tests := []struct {
+		name     string
+		hand1    *BadugiHand
+		hand2    *BadugiHand
+		expected int
+	}{
+		{"any badugi beats a three-card hand", badugi("Kh", "Qd", "Jc", "Ts"), badugi("Ah", "2h", "3d", "4c"), 1},
+		{"lower high card wins", badugi("8h", "5d", "3c", "As"), badugi("9h", "4d", "3c", "As"), 1},
+		{"next card breaks the tie", badugi("8h", "5d", "3c", "As"), badugi("8d", "6c", "3s", "Ah"), 1},
+		{"ace is lower than deuce", badugi("8h", "5d", "3c", "As"), badugi("8d", "5c", "3s", "2h"), 1},
+		{"three-card hand beats two-card hand", badugi("Kh", "Qh", "Jd", "Tc"), badugi("Ah", "2h", "3d", "4d"), 1},
+		{"suits do not matter", badugi("4h", "3d", "2c", "As"), badugi("4s", "3c", "2d", "Ah"), 0},
+		{"nil loses", nil, badugi("9h", "9d", "9c", "9s"), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareBadugiHands(tt.hand1, tt.hand2); got != tt.expected {
+				t.Errorf("CompareBadugiHands(%v, %v) = %d, want %d", tt.hand1, tt.hand2, got, tt.expected)
+			}
+		})
+	}
+}
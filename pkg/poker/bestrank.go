@@ -0,0 +1,205 @@
+package poker
+
+// bestFive describes the strongest 5-card hand found among a set of cards,
+// in enough detail to pick the winning cards back out of the input.
+type bestFive struct {
+	rank      HandRank
+	flush     bool    // True if the hand is a flush or straight flush
+	flushSuit Suit    // Suit of the flush, when flush is true
+	flushMask uint16  // Rank mask of the five flush cards, when flush is true
+	ranks     [5]Rank // The five ranks used, with multiplicity, when flush is false
+}
+
+// wheelMask is the rank mask of the wheel straight (A-2-3-4-5).
+const wheelMask = 1<<12 | 0xF
+
+// straightMask returns the rank mask of the highest straight contained in
+// mask, or 0 if there is none. The wheel (A-2-3-4-5) is the lowest straight.
+func straightMask(mask uint16) uint16 {
+	for shift := 8; shift >= 0; shift-- {
+		window := uint16(0x1F) << uint(shift)
+		if mask&window == window {
+			return window
+		}
+	}
+	if mask&wheelMask == wheelMask {
+		return wheelMask
+	}
+	return 0
+}
+
+// topRanks clears the lowest set bits of mask until only n remain.
+func topRanks(mask uint16, n int) uint16 {
+	for countBits(mask) > n {
+		mask &= mask - 1
+	}
+	return mask
+}
+
+// evaluateSuitMasks finds the best 5-card hand contained in the given
+// per-suit rank masks (bit 0 is Two, bit 12 is Ace). It works directly on
+// rank counts and suit masks, so no 5-card combinations are generated.
+// The returned rank is 0 if fewer than 5 cards are present.
+func evaluateSuitMasks(suits *[4]uint16) bestFive {
+	var best bestFive
+
+	var counts [15]uint8
+	total := 0
+	for r := Two; r <= Ace; r++ {
+		bit := rankBit(r)
+		for s := 0; s < 4; s++ {
+			if suits[s]&bit != 0 {
+				counts[r]++
+			}
+		}
+		total += int(counts[r])
+	}
+	if total < 5 {
+		return best
+	}
+
+	// Flushes: the best straight flush or top five cards of any 5+ card suit
+	for s := 0; s < 4; s++ {
+		if countBits(suits[s]) < 5 {
+			continue
+		}
+		mask := straightMask(suits[s])
+		if mask == 0 {
+			mask = topRanks(suits[s], 5)
+		}
+		if rank := flushRanks[mask]; rank > best.rank {
+			best = bestFive{rank: rank, flush: true, flushSuit: Suit(s), flushMask: mask}
+		}
+	}
+
+	// Non-flush hands, built from rank counts alone
+	var five [5]Rank
+	n := 0
+	take := func(r Rank, k int) {
+		for ; k > 0 && n < 5; k-- {
+			five[n] = r
+			n++
+		}
+	}
+	// highest returns the highest rank with at least min cards, skipping the given ranks
+	highest := func(min uint8, skip1, skip2 Rank) Rank {
+		for r := Ace; r >= Two; r-- {
+			if counts[r] >= min && r != skip1 && r != skip2 {
+				return r
+			}
+		}
+		return 0
+	}
+	// fill completes the hand with the highest single kickers not already used
+	fill := func(skip1, skip2 Rank) {
+		for r := Ace; r >= Two && n < 5; r-- {
+			if counts[r] > 0 && r != skip1 && r != skip2 {
+				take(r, 1)
+			}
+		}
+	}
+
+	all := suits[0] | suits[1] | suits[2] | suits[3]
+	if quad := highest(4, 0, 0); quad != 0 {
+		take(quad, 4)
+		fill(quad, 0)
+	} else if trip := highest(3, 0, 0); trip != 0 && highest(2, trip, 0) != 0 {
+		take(trip, 3)
+		take(highest(2, trip, 0), 2)
+	} else if mask := straightMask(all); mask != 0 {
+		for r := Ace; r >= Two; r-- {
+			if mask&rankBit(r) != 0 {
+				take(r, 1)
+			}
+		}
+	} else if trip != 0 {
+		take(trip, 3)
+		fill(trip, 0)
+	} else if pair := highest(2, 0, 0); pair != 0 {
+		take(pair, 2)
+		if second := highest(2, pair, 0); second != 0 {
+			take(second, 2)
+			fill(pair, second)
+		} else {
+			fill(pair, 0)
+		}
+	} else {
+		fill(0, 0)
+	}
+
+	if rank := rankOfFive(&five); rank > best.rank {
+		best = bestFive{rank: rank, ranks: five}
+	}
+
+	return best
+}
+
+// rankOfFive looks up the HandRank of five unsuited ranks.
+func rankOfFive(five *[5]Rank) HandRank {
+	var mask uint16
+	product := uint32(1)
+	for _, r := range five {
+		mask |= rankBit(r)
+		product *= rankPrimes[r]
+	}
+	if countBits(mask) == 5 {
+		return uniqueRanks[mask]
+	}
+	return lookupPaired(product)
+}
+
+// cardSuitMasks builds per-suit rank masks from cards.
+// Returns false if any card is invalid or appears more than once.
+func cardSuitMasks(cards []Card) ([4]uint16, bool) {
+	var suits [4]uint16
+	for _, card := range cards {
+		if card.Rank < Two || card.Rank > Ace || card.Suit < Hearts || card.Suit > Spades {
+			return suits, false
+		}
+		bit := rankBit(card.Rank)
+		if suits[card.Suit]&bit != 0 {
+			return suits, false
+		}
+		suits[card.Suit] |= bit
+	}
+	return suits, true
+}
+
+// pick returns the five input cards that make up the hand, in input order.
+func (b *bestFive) pick(cards []Card) []Card {
+	var need [15]uint8
+	if !b.flush {
+		for _, r := range b.ranks {
+			need[r]++
+		}
+	}
+
+	picked := make([]Card, 0, 5)
+	for _, card := range cards {
+		if b.flush {
+			if card.Suit == b.flushSuit && b.flushMask&rankBit(card.Rank) != 0 {
+				picked = append(picked, card)
+			}
+		} else if need[card.Rank] > 0 {
+			need[card.Rank]--
+			picked = append(picked, card)
+		}
+	}
+	return picked
+}
+
+// EvaluateBestHandRank returns the HandRank of the best 5-card hand that can
+// be made from 5 or more cards (typically 6 or 7). It evaluates the cards in
+// a single pass over rank and suit bitmasks instead of enumerating 5-card
+// combinations, and performs no allocations.
+// Returns 0 if fewer than 5 cards are given or any card is invalid or duplicated.
+func EvaluateBestHandRank(cards []Card) HandRank {
+	if len(cards) < 5 {
+		return 0
+	}
+	suits, ok := cardSuitMasks(cards)
+	if !ok {
+		return 0
+	}
+	return evaluateSuitMasks(&suits).rank
+}
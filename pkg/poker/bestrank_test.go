@@ -0,0 +1,127 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestEvaluateBestHandRankKnownHands checks the single-pass evaluator on
+// 7-card hands where several categories compete.
+func TestEvaluateBestHandRankKnownHands(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"flush beats straight", []string{"Ah", "Kh", "Qh", "Jh", "9h", "8d", "Tc"}, Flush, []Rank{Ace, King, Queen, Jack, Nine}},
+		{"two trips make a full house", []string{"9h", "9d", "9c", "4s", "4h", "4d", "Ac"}, FullHouse, []Rank{Nine, Four}},
+		{"three pairs use best kicker", []string{"Kh", "Kd", "7c", "7s", "3h", "3d", "2c"}, TwoPair, []Rank{King, Seven, Three}},
+		{"quads with paired board kicker", []string{"8h", "8d", "8c", "8s", "Jh", "Jd", "2c"}, FourOfAKind, []Rank{Eight, Jack}},
+		{"wheel straight flush over flush", []string{"Ad", "2d", "3d", "4d", "5d", "Kd", "Qc"}, StraightFlush, []Rank{Five}},
+		{"royal flush", []string{"Ts", "Js", "Qs", "Ks", "As", "9s", "2h"}, RoyalFlush, []Rank{}},
+		{"highest straight wins", []string{"4h", "5d", "6c", "7s", "8h", "9d", "2c"}, Straight, []Rank{Nine}},
+		{"six cards high card", []string{"Ah", "Jd", "9c", "7s", "5h", "3d"}, HighCard, []Rank{Ace, Jack, Nine, Seven, Five}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank := EvaluateBestHandRank(mustParseCards(t, tt.cards...))
+			if rank.Category() != tt.category {
+				t.Errorf("Category = %v, want %v", rank.Category(), tt.category)
+			}
+			if !reflect.DeepEqual(rank.Tiebreakers(), tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", rank.Tiebreakers(), tt.tiebreakers)
+			}
+		})
+	}
+}
+
+// TestEvaluateBestHandRankInvalidInput verifies that short, invalid and
+// duplicated input returns 0.
+func TestEvaluateBestHandRankInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"four cards", mustParseCards(t, "Ah", "Kh", "Qh", "Jh")},
+		{"duplicate card", mustParseCards(t, "Ah", "Ah", "Qh", "Jh", "Th", "2c")},
+		{"invalid suit", []Card{{Rank: Ace, Suit: 7}, {Rank: King, Suit: Hearts}, {Rank: Queen, Suit: Hearts}, {Rank: Jack, Suit: Hearts}, {Rank: Ten, Suit: Hearts}, {Rank: Two, Suit: Clubs}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateBestHandRank(tt.cards); got != 0 {
+				t.Errorf("EvaluateBestHandRank() = %d, want 0", got)
+			}
+		})
+	}
+}
+
+// TestFindBestHandMatchesCombinations compares the single-pass FindBestHand
+// with exhaustive combination search on random 6- and 7-card deals.
+func TestFindBestHandMatchesCombinations(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	deck := NewDeck().Cards
+
+	for i := 0; i < 20000; i++ {
+		n := 6 + i%2
+		perm := rng.Perm(len(deck))
+		cards := make([]Card, n)
+		for j := range cards {
+			cards[j] = deck[perm[j]]
+		}
+
+		got := FindBestHand(cards)
+		want := findBestHandByCombinations(cards)
+
+		if got.Category != want.Category || !reflect.DeepEqual(got.Tiebreakers, want.Tiebreakers) {
+			t.Fatalf("cards %v: got %v %v, want %v %v", cards, got.Category, got.Tiebreakers, want.Category, want.Tiebreakers)
+		}
+		if EvaluateBestHandRank(cards) != EvaluateHandRank(got.Cards) {
+			t.Fatalf("cards %v: winning cards %v do not produce the best rank", cards, got.Cards)
+		}
+		for _, card := range got.Cards {
+			if !containsCard(cards, card) {
+				t.Fatalf("cards %v: winning card %v is not part of the input", cards, card)
+			}
+		}
+	}
+}
+
+// TestFindBestHandDuplicateCardsFallback verifies that input the bitmask
+// evaluator rejects is still evaluated by combination search.
+func TestFindBestHandDuplicateCardsFallback(t *testing.T) {
+	cards := mustParseCards(t, "Ah", "Ah", "Kd", "Kc", "7s", "2h")
+
+	hand := FindBestHand(cards)
+	if hand == nil {
+		t.Fatal("FindBestHand returned nil, expected valid hand")
+	}
+	if hand.Category != TwoPair {
+		t.Errorf("Category = %v, want %v", hand.Category, TwoPair)
+	}
+}
+
+// TestEvaluateBestHandRankZeroAllocations verifies the single-pass path never allocates.
+func TestEvaluateBestHandRankZeroAllocations(t *testing.T) {
+	cards := mustParseCards(t, "Jh", "Jd", "Jc", "Ks", "Qh", "8d", "3c")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = EvaluateBestHandRank(cards)
+	})
+	if allocs != 0 {
+		t.Errorf("EvaluateBestHandRank allocated %.1f times per run, want 0", allocs)
+	}
+}
+
+// containsCard reports whether card appears in cards.
+func containsCard(cards []Card, card Card) bool {
+	for _, c := range cards {
+		if c == card {
+			return true
+		}
+	}
+	return false
+}
@@ -25,6 +25,11 @@ const (
 	Queen Rank = 12
 	King  Rank = 13
 	Ace   Rank = 14
+
+	// Joker is the rank of a joker. Jokers are not natural cards: they are
+	// only meaningful to wild-card evaluation, and EvaluateHand and
+	// FindBestHand return nil for them.
+	Joker Rank = 15
 )
 
 // Suit represents the suit of a playing card
@@ -73,6 +78,8 @@ func (r Rank) String() string {
 		return "3"
 	case Two:
 		return "2"
+	case Joker:
+		return "Jk"
 	default:
 		return "?"
 	}
@@ -94,14 +101,40 @@ func (s Suit) String() string {
 	}
 }
 
-// String returns the card notation (e.g., "Ah" for Ace of Hearts)
+// The two jokers of a deck. A joker's suit only tells the copies apart.
+var (
+	RedJoker   = Card{Rank: Joker, Suit: Hearts}
+	BlackJoker = Card{Rank: Joker, Suit: Spades}
+)
+
+// IsJoker reports whether the card is a joker.
+func (c Card) IsJoker() bool {
+	return c.Rank == Joker
+}
+
+// String returns the card notation (e.g., "Ah" for Ace of Hearts).
+// Jokers are "Jr" (red) and "Jb" (black).
 func (c Card) String() string {
+	switch c {
+	case RedJoker:
+		return "Jr"
+	case BlackJoker:
+		return "Jb"
+	}
 	return c.Rank.String() + c.Suit.String()
 }
 
 // ParseCard parses a card string (e.g., "Ah", "Kd", "10s") into a Card struct.
 // Accepts both "T" and "10" for Ten. Case-insensitive for suits.
+// The jokers are written "Jr" (red) and "Jb" (black).
 func ParseCard(s string) (Card, error) {
+	switch {
+	case strings.EqualFold(s, "Jr"):
+		return RedJoker, nil
+	case strings.EqualFold(s, "Jb"):
+		return BlackJoker, nil
+	}
+
 	if len(s) < 2 {
 		return Card{}, fmt.Errorf("invalid card string: %q (too short)", s)
 	}
@@ -119,53 +152,66 @@ func ParseCard(s string) (Card, error) {
 		return Card{}, fmt.Errorf("invalid card string: %q (invalid length)", s)
 	}
 
-	// Parse rank
-	var rank Rank
-	switch strings.ToUpper(rankStr) {
+	rank, err := parseRank(rankStr)
+	if err != nil {
+		return Card{}, err
+	}
+
+	suit, err := parseSuit(suitStr)
+	if err != nil {
+		return Card{}, err
+	}
+
+	return Card{Rank: rank, Suit: suit}, nil
+}
+
+// parseRank parses a rank string (e.g., "A", "t", "10").
+// Accepts both "T" and "10" for Ten. Case-insensitive.
+func parseRank(s string) (Rank, error) {
+	switch strings.ToUpper(s) {
 	case "A":
-		rank = Ace
+		return Ace, nil
 	case "K":
-		rank = King
+		return King, nil
 	case "Q":
-		rank = Queen
+		return Queen, nil
 	case "J":
-		rank = Jack
+		return Jack, nil
 	case "T", "10":
-		rank = Ten
+		return Ten, nil
 	case "9":
-		rank = Nine
+		return Nine, nil
 	case "8":
-		rank = Eight
+		return Eight, nil
 	case "7":
-		rank = Seven
+		return Seven, nil
 	case "6":
-		rank = Six
+		return Six, nil
 	case "5":
-		rank = Five
+		return Five, nil
 	case "4":
-		rank = Four
+		return Four, nil
 	case "3":
-		rank = Three
+		return Three, nil
 	case "2":
-		rank = Two
+		return Two, nil
 	default:
-		return Card{}, fmt.Errorf("invalid rank: %q", rankStr)
+		return 0, fmt.Errorf("invalid rank: %q", s)
 	}
+}
 
-	// Parse suit (case-insensitive)
-	var suit Suit
-	switch strings.ToLower(suitStr) {
+// parseSuit parses a suit string ("h", "d", "c" or "s"). Case-insensitive.
+func parseSuit(s string) (Suit, error) {
+	switch strings.ToLower(s) {
 	case "h":
-		suit = Hearts
+		return Hearts, nil
 	case "d":
-		suit = Diamonds
+		return Diamonds, nil
 	case "c":
-		suit = Clubs
+		return Clubs, nil
 	case "s":
-		suit = Spades
+		return Spades, nil
 	default:
-		return Card{}, fmt.Errorf("invalid suit: %q", suitStr)
+		return 0, fmt.Errorf("invalid suit: %q", s)
 	}
-
-	return Card{Rank: rank, Suit: suit}, nil
 }
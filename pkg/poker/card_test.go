@@ -142,6 +142,8 @@ func TestCardString(t *testing.T) {
 		{Card{Rank: Jack, Suit: Spades}, "Js"},
 		{Card{Rank: Ten, Suit: Hearts}, "Th"},
 		{Card{Rank: Two, Suit: Clubs}, "2c"},
+		{RedJoker, "Jr"},
+		{BlackJoker, "Jb"},
 	}
 
 	for _, tt := range tests {
@@ -244,6 +246,38 @@ func TestParseCardCaseInsensitive(t *testing.T) {
 	}
 }
 
+// Test ParseCard with joker notation
+func TestParseCardJokers(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Card
+	}{
+		{"Jr", RedJoker},
+		{"JR", RedJoker},
+		{"Jb", BlackJoker},
+		{"jb", BlackJoker},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseCard(tt.input)
+			if err != nil {
+				t.Fatalf("ParseCard(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.expected || !got.IsJoker() {
+				t.Errorf("ParseCard(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if (Card{Rank: Ace, Suit: Hearts}).IsJoker() {
+		t.Error("Ah should not be a joker")
+	}
+	if RedJoker.Index().Valid() {
+		t.Error("jokers should not have a card index")
+	}
+}
+
 // Test ParseCard error cases
 func TestParseCardErrors(t *testing.T) {
 	tests := []struct {
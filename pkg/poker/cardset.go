@@ -0,0 +1,181 @@
+package poker
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// CardIndex is a compact encoding of a standard playing card as a number
+// from 0 to 51. Cards are numbered in NewDeck order: suit-major (Hearts,
+// Diamonds, Clubs, Spades), then rank from Two to Ace within each suit.
+type CardIndex uint8
+
+// NoCard is the CardIndex returned for cards that have no standard encoding.
+const NoCard CardIndex = 0xFF
+
+// NumCards is the number of cards in a standard deck, and the number of
+// distinct valid CardIndex values.
+const NumCards = 52
+
+// suitMaskBits is the mask for one suit's 13 rank bits inside a CardSet.
+const suitMaskBits = 1<<13 - 1
+
+// Index returns the card's CardIndex, or NoCard if the rank or suit is invalid.
+func (c Card) Index() CardIndex {
+	if c.Rank < Two || c.Rank > Ace || c.Suit < Hearts || c.Suit > Spades {
+		return NoCard
+	}
+	return CardIndex(int(c.Suit)*13 + int(c.Rank-Two))
+}
+
+// Valid reports whether the index refers to one of the 52 standard cards.
+func (i CardIndex) Valid() bool {
+	return i < NumCards
+}
+
+// Card converts the index back to a Card.
+// Returns the zero Card if the index is not valid.
+func (i CardIndex) Card() Card {
+	if !i.Valid() {
+		return Card{}
+	}
+	return Card{Rank: Two + Rank(i%13), Suit: Suit(i / 13)}
+}
+
+// String returns the card notation of the index (e.g., "Ah").
+func (i CardIndex) String() string {
+	if !i.Valid() {
+		return "??"
+	}
+	return i.Card().String()
+}
+
+// CardSet is a set of standard playing cards stored as a 64-bit mask,
+// where bit i is set if the card with CardIndex i is present.
+// The zero value is an empty set.
+type CardSet uint64
+
+// FullDeckSet contains all 52 standard cards.
+const FullDeckSet CardSet = 1<<NumCards - 1
+
+// NewCardSet converts cards to a CardSet.
+// Returns an error if a card is invalid or appears more than once,
+// since the set could not be converted back without loss.
+func NewCardSet(cards []Card) (CardSet, error) {
+	var set CardSet
+	for _, card := range cards {
+		index := card.Index()
+		if !index.Valid() {
+			return 0, fmt.Errorf("invalid card: %v", card)
+		}
+		if set.Contains(index) {
+			return 0, fmt.Errorf("duplicate card: %v", card)
+		}
+		set.Add(index)
+	}
+	return set, nil
+}
+
+// Add inserts the card with the given index. Invalid indices are ignored.
+func (s *CardSet) Add(i CardIndex) {
+	if i.Valid() {
+		*s |= 1 << i
+	}
+}
+
+// Remove deletes the card with the given index, if present.
+func (s *CardSet) Remove(i CardIndex) {
+	if i.Valid() {
+		*s &^= 1 << i
+	}
+}
+
+// Contains reports whether the card with the given index is in the set.
+func (s CardSet) Contains(i CardIndex) bool {
+	return i.Valid() && s&(1<<i) != 0
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Union returns the cards that are in either set.
+func (s CardSet) Union(other CardSet) CardSet {
+	return s | other
+}
+
+// Intersect returns the cards that are in both sets.
+func (s CardSet) Intersect(other CardSet) CardSet {
+	return s & other
+}
+
+// Difference returns the cards in s that are not in other.
+func (s CardSet) Difference(other CardSet) CardSet {
+	return s &^ other
+}
+
+// All returns an iterator over the card indices in the set, in ascending order.
+func (s CardSet) All() iter.Seq[CardIndex] {
+	return func(yield func(CardIndex) bool) {
+		for rest := uint64(s); rest != 0; rest &= rest - 1 {
+			if !yield(CardIndex(bits.TrailingZeros64(rest))) {
+				return
+			}
+		}
+	}
+}
+
+// Cards converts the set to a slice of cards in ascending CardIndex order.
+func (s CardSet) Cards() []Card {
+	cards := make([]Card, 0, s.Count())
+	for i := range s.All() {
+		cards = append(cards, i.Card())
+	}
+	return cards
+}
+
+// String returns the cards in the set in notation form (e.g., "[Ah Kd]").
+func (s CardSet) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i := range s.All() {
+		if sb.Len() > 1 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(i.String())
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// suitMasks splits the set into one 13-bit rank mask per suit.
+func (s CardSet) suitMasks() [4]uint16 {
+	return [4]uint16{
+		uint16(s & suitMaskBits),
+		uint16(s >> 13 & suitMaskBits),
+		uint16(s >> 26 & suitMaskBits),
+		uint16(s >> 39 & suitMaskBits),
+	}
+}
+
+// EvaluateCardSet returns the HandRank of the best 5-card hand in the set,
+// which typically holds 5 to 7 cards. It performs no allocations.
+// Returns 0 if the set contains fewer than 5 cards.
+func EvaluateCardSet(s CardSet) HandRank {
+	suits := s.suitMasks()
+	return evaluateSuitMasks(&suits).rank
+}
+
+// FindBestHandInSet returns the best 5-card hand in the set, like FindBestHand.
+// Returns nil if the set contains fewer than 5 cards.
+func FindBestHandInSet(s CardSet) *Hand {
+	suits := s.suitMasks()
+	best := evaluateSuitMasks(&suits)
+	if best.rank == 0 {
+		return nil
+	}
+	return best.rank.Hand(best.pick(s.Cards()))
+}
@@ -0,0 +1,197 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCardIndexRoundTrip verifies that every card in the deck converts to a
+// unique index in NewDeck order and back without loss.
+func TestCardIndexRoundTrip(t *testing.T) {
+	for i, card := range NewDeck().Cards {
+		index := card.Index()
+		if index != CardIndex(i) {
+			t.Errorf("%v.Index() = %d, want %d", card, index, i)
+		}
+		if index.Card() != card {
+			t.Errorf("CardIndex(%d).Card() = %v, want %v", index, index.Card(), card)
+		}
+		if index.String() != card.String() {
+			t.Errorf("CardIndex(%d).String() = %q, want %q", index, index.String(), card.String())
+		}
+	}
+}
+
+// TestCardIndexInvalid verifies that invalid cards map to NoCard.
+func TestCardIndexInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		card Card
+	}{
+		{"zero card", Card{}},
+		{"rank too high", Card{Rank: 15, Suit: Hearts}},
+		{"invalid suit", Card{Rank: Ace, Suit: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.card.Index(); got != NoCard {
+				t.Errorf("Index() = %d, want NoCard", got)
+			}
+		})
+	}
+
+	if NoCard.Valid() {
+		t.Error("NoCard.Valid() = true, want false")
+	}
+	if NoCard.Card() != (Card{}) {
+		t.Errorf("NoCard.Card() = %v, want zero Card", NoCard.Card())
+	}
+}
+
+// TestCardSetOperations exercises Add, Remove, Contains and Count.
+func TestCardSetOperations(t *testing.T) {
+	ah := Card{Rank: Ace, Suit: Hearts}.Index()
+	ks := Card{Rank: King, Suit: Spades}.Index()
+
+	var set CardSet
+	set.Add(ah)
+	set.Add(ks)
+	set.Add(ah)
+	set.Add(NoCard)
+
+	if set.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", set.Count())
+	}
+	if !set.Contains(ah) || !set.Contains(ks) {
+		t.Errorf("set %v should contain Ah and Ks", set)
+	}
+	if set.Contains(NoCard) {
+		t.Error("Contains(NoCard) = true, want false")
+	}
+
+	set.Remove(ah)
+	if set.Contains(ah) {
+		t.Error("Contains(Ah) after Remove = true, want false")
+	}
+	if set.Count() != 1 {
+		t.Errorf("Count() after Remove = %d, want 1", set.Count())
+	}
+}
+
+// TestCardSetAlgebra verifies Union, Intersect and Difference.
+func TestCardSetAlgebra(t *testing.T) {
+	a, _ := NewCardSet(mustParseCards(t, "Ah", "Kh", "Qh"))
+	b, _ := NewCardSet(mustParseCards(t, "Qh", "Jh"))
+
+	tests := []struct {
+		name string
+		got  CardSet
+		want []string
+	}{
+		{"union", a.Union(b), []string{"Qh", "Kh", "Ah", "Jh"}},
+		{"intersect", a.Intersect(b), []string{"Qh"}},
+		{"difference", a.Difference(b), []string{"Kh", "Ah"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, _ := NewCardSet(mustParseCards(t, tt.want...))
+			if tt.got != want {
+				t.Errorf("got %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+// TestNewCardSetRoundTrip verifies lossless conversion between []Card and CardSet.
+func TestNewCardSetRoundTrip(t *testing.T) {
+	cards := mustParseCards(t, "2h", "Ah", "3d", "Tc", "Ks")
+
+	set, err := NewCardSet(cards)
+	if err != nil {
+		t.Fatalf("NewCardSet returned error: %v", err)
+	}
+
+	got := set.Cards()
+	if !reflect.DeepEqual(got, cards) {
+		t.Errorf("Cards() = %v, want %v", got, cards)
+	}
+	if set.String() != "[2h Ah 3d Tc Ks]" {
+		t.Errorf("String() = %q, want %q", set.String(), "[2h Ah 3d Tc Ks]")
+	}
+}
+
+// TestNewCardSetErrors verifies that invalid and duplicate cards are rejected.
+func TestNewCardSetErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"duplicate card", mustParseCards(t, "Ah", "Kd", "Ah")},
+		{"invalid card", []Card{{Rank: 0, Suit: Hearts}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCardSet(tt.cards); err == nil {
+				t.Error("NewCardSet should return an error")
+			}
+		})
+	}
+}
+
+// TestCardSetAllStopsEarly verifies that iteration honors a break.
+func TestCardSetAllStopsEarly(t *testing.T) {
+	visited := 0
+	for range FullDeckSet.All() {
+		visited++
+		if visited == 3 {
+			break
+		}
+	}
+	if visited != 3 {
+		t.Errorf("visited %d cards, want 3", visited)
+	}
+	if FullDeckSet.Count() != NumCards {
+		t.Errorf("FullDeckSet.Count() = %d, want %d", FullDeckSet.Count(), NumCards)
+	}
+}
+
+// TestEvaluateCardSetMatchesFindBestHand verifies that set evaluation agrees
+// with the slice-based evaluator.
+func TestEvaluateCardSetMatchesFindBestHand(t *testing.T) {
+	cards := mustParseCards(t, "Jh", "Jd", "Jc", "Ks", "Kh", "8d", "3c")
+	set, _ := NewCardSet(cards)
+
+	if got, want := EvaluateCardSet(set), EvaluateBestHandRank(cards); got != want {
+		t.Errorf("EvaluateCardSet() = %d, want %d", got, want)
+	}
+
+	hand := FindBestHandInSet(set)
+	if hand == nil {
+		t.Fatal("FindBestHandInSet returned nil, expected valid hand")
+	}
+	if hand.Category != FullHouse || !reflect.DeepEqual(hand.Tiebreakers, []Rank{Jack, King}) {
+		t.Errorf("FindBestHandInSet() = %v %v, want Full House [J K]", hand.Category, hand.Tiebreakers)
+	}
+	if len(hand.Cards) != 5 {
+		t.Errorf("FindBestHandInSet cards length = %d, want 5", len(hand.Cards))
+	}
+
+	if FindBestHandInSet(set&0xF) != nil {
+		t.Error("FindBestHandInSet with 4 cards should return nil")
+	}
+}
+
+// TestEvaluateCardSetZeroAllocations verifies the set path never allocates.
+func TestEvaluateCardSetZeroAllocations(t *testing.T) {
+	set, _ := NewCardSet(mustParseCards(t, "Ah", "Kh", "Qh", "Jh", "9d", "2c", "2s"))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = EvaluateCardSet(set)
+	})
+	if allocs != 0 {
+		t.Errorf("EvaluateCardSet allocated %.1f times per run, want 0", allocs)
+	}
+}
@@ -1,5 +1,10 @@
 package poker
 
+import (
+	"iter"
+	"math/bits"
+)
+
 // Combinations generates all k-card combinations from the given cards.
 // Uses a recursive algorithm to generate all possible selections.
 // For example, Combinations(7 cards, 5) returns 21 combinations (C(7,5) = 21).
@@ -50,3 +55,53 @@ func generate(cards []Card, k int, start int, current []Card, result *[][]Card)
 		generate(cards, k-1, i+1, append(current, cards[i]), result)
 	}
 }
+
+// CombinationsOfSet returns an iterator over all k-card subsets of the set.
+// Unlike Combinations it allocates no slices, which makes it suitable for
+// hot loops such as enumerating every remaining board.
+// For example, ranging over CombinationsOfSet(set of 7 cards, 5) yields 21 sets.
+func CombinationsOfSet(s CardSet, k int) iter.Seq[CardSet] {
+	return func(yield func(CardSet) bool) {
+		n := s.Count()
+		if k < 0 || k > n {
+			return
+		}
+
+		// Collect the single-card bits of the set so positions can be indexed
+		var cards [NumCards]CardSet
+		i := 0
+		for rest := uint64(s); rest != 0; rest &= rest - 1 {
+			cards[i] = CardSet(1) << uint(bits.TrailingZeros64(rest))
+			i++
+		}
+
+		// positions holds the chosen card positions in increasing order
+		var positions [NumCards]int
+		for j := 0; j < k; j++ {
+			positions[j] = j
+		}
+
+		for {
+			var combo CardSet
+			for j := 0; j < k; j++ {
+				combo |= cards[positions[j]]
+			}
+			if !yield(combo) {
+				return
+			}
+
+			// Advance the rightmost position that still has room to move
+			j := k - 1
+			for j >= 0 && positions[j] == n-k+j {
+				j--
+			}
+			if j < 0 {
+				return
+			}
+			positions[j]++
+			for m := j + 1; m < k; m++ {
+				positions[m] = positions[m-1] + 1
+			}
+		}
+	}
+}
@@ -167,3 +167,63 @@ func TestCombinationsEdgeCase3From4(t *testing.T) {
 		}
 	}
 }
+
+func TestCombinationsOfSetCounts(t *testing.T) {
+	set, _ := NewCardSet([]Card{
+		{Rank: Ace, Suit: Hearts},
+		{Rank: King, Suit: Hearts},
+		{Rank: Queen, Suit: Clubs},
+		{Rank: Jack, Suit: Spades},
+		{Rank: Ten, Suit: Hearts},
+		{Rank: Nine, Suit: Diamonds},
+		{Rank: Eight, Suit: Clubs},
+	})
+
+	tests := []struct {
+		k    int
+		want int
+	}{
+		{0, 1},
+		{2, 21},
+		{5, 21},
+		{7, 1},
+		{8, 0},
+		{-1, 0},
+	}
+
+	for _, tt := range tests {
+		seen := make(map[CardSet]bool)
+		for combo := range CombinationsOfSet(set, tt.k) {
+			if combo.Count() != tt.k {
+				t.Errorf("CombinationsOfSet(7, %d) yielded %d cards", tt.k, combo.Count())
+			}
+			if combo.Difference(set) != 0 {
+				t.Errorf("CombinationsOfSet(7, %d) yielded cards outside the set: %v", tt.k, combo)
+			}
+			if seen[combo] {
+				t.Errorf("CombinationsOfSet(7, %d) yielded duplicate %v", tt.k, combo)
+			}
+			seen[combo] = true
+		}
+		if len(seen) != tt.want {
+			t.Errorf("CombinationsOfSet(7, %d) = %d combinations, want %d", tt.k, len(seen), tt.want)
+		}
+	}
+}
+
+func TestCombinationsOfSetFullDeckBoards(t *testing.T) {
+	// C(48,5) = 1,712,304 boards remain after two hole cards each for two players
+	deck := FullDeckSet
+	for _, card := range []Card{{Rank: Ace, Suit: Hearts}, {Rank: King, Suit: Hearts}, {Rank: Queen, Suit: Spades}, {Rank: Queen, Suit: Diamonds}} {
+		deck.Remove(card.Index())
+	}
+
+	count := 0
+	for range CombinationsOfSet(deck, 5) {
+		count++
+	}
+
+	if count != 1712304 {
+		t.Errorf("CombinationsOfSet(48, 5) = %d combinations, want 1712304", count)
+	}
+}
@@ -7,10 +7,25 @@ type Deck struct {
 	Cards []Card
 }
 
-// NewDeck creates and returns a new deck containing all 52 standard playing cards
-func NewDeck() *Deck {
+// DeckOption customizes a deck created by NewDeck or NewShortDeck.
+type DeckOption func(*Deck)
+
+// WithJokers adds n jokers (at most 2: the red joker, then the black joker)
+// after the regular cards. Jokers have no CardIndex, so Deck.CardSet and
+// DealSet skip them.
+func WithJokers(n int) DeckOption {
+	return func(d *Deck) {
+		for _, joker := range []Card{RedJoker, BlackJoker}[:max(0, min(n, 2))] {
+			d.Cards = append(d.Cards, joker)
+		}
+	}
+}
+
+// NewDeck creates and returns a new deck containing all 52 standard playing
+// cards, followed by any cards added by options such as WithJokers.
+func NewDeck(opts ...DeckOption) *Deck {
 	deck := &Deck{
-		Cards: make([]Card, 0, 52),
+		Cards: make([]Card, 0, 54),
 	}
 
 	// Generate all combinations of ranks and suits
@@ -23,6 +38,32 @@ func NewDeck() *Deck {
 		}
 	}
 
+	for _, opt := range opts {
+		opt(deck)
+	}
+	return deck
+}
+
+// NewShortDeck creates and returns a 36-card Short Deck (6+ Hold'em) deck,
+// which has no twos through fives. Cards follow the same order as NewDeck.
+// Evaluate Short Deck hands with ShortDeckRules.
+func NewShortDeck(opts ...DeckOption) *Deck {
+	deck := &Deck{
+		Cards: make([]Card, 0, 38),
+	}
+
+	ranks := []Rank{Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace}
+	suits := []Suit{Hearts, Diamonds, Clubs, Spades}
+
+	for _, suit := range suits {
+		for _, rank := range ranks {
+			deck.Cards = append(deck.Cards, Card{Rank: rank, Suit: suit})
+		}
+	}
+
+	for _, opt := range opts {
+		opt(deck)
+	}
 	return deck
 }
 
@@ -41,3 +82,40 @@ func (d *Deck) Deal(n int) ([]Card, error) {
 
 	return dealt, nil
 }
+
+// CardSet returns the cards remaining in the deck as a CardSet.
+// Cards without a standard encoding are left out.
+func (d *Deck) CardSet() CardSet {
+	var set CardSet
+	for _, card := range d.Cards {
+		set.Add(card.Index())
+	}
+	return set
+}
+
+// Remove takes every card in set out of the deck, preserving the order of
+// the remaining cards. Useful for removing known hole cards or board cards.
+func (d *Deck) Remove(set CardSet) {
+	remaining := d.Cards[:0]
+	for _, card := range d.Cards {
+		if !set.Contains(card.Index()) {
+			remaining = append(remaining, card)
+		}
+	}
+	d.Cards = remaining
+}
+
+// DealSet removes the top n cards from the deck and returns them as a CardSet.
+// Returns an error if n is greater than the number of available cards.
+func (d *Deck) DealSet(n int) (CardSet, error) {
+	cards, err := d.Deal(n)
+	if err != nil {
+		return 0, err
+	}
+
+	var set CardSet
+	for _, card := range cards {
+		set.Add(card.Index())
+	}
+	return set, nil
+}
@@ -57,6 +57,58 @@ func TestDeckNoDuplicates(t *testing.T) {
 	}
 }
 
+// Test that NewShortDeck has 36 distinct cards from six through ace
+func TestNewShortDeck(t *testing.T) {
+	deck := NewShortDeck()
+	if len(deck.Cards) != 36 {
+		t.Fatalf("NewShortDeck should create 36 cards, got %d", len(deck.Cards))
+	}
+
+	seen := make(map[Card]bool)
+	for _, card := range deck.Cards {
+		if card.Rank < Six || card.Rank > Ace {
+			t.Errorf("Short deck contains %v", card)
+		}
+		if seen[card] {
+			t.Errorf("Duplicate card found: %v", card)
+		}
+		seen[card] = true
+	}
+}
+
+// Test that WithJokers appends jokers after the regular cards
+func TestNewDeckWithJokers(t *testing.T) {
+	tests := []struct {
+		jokers int
+		want   []Card
+	}{
+		{0, nil},
+		{1, []Card{RedJoker}},
+		{2, []Card{RedJoker, BlackJoker}},
+		{5, []Card{RedJoker, BlackJoker}},
+	}
+
+	for _, tt := range tests {
+		deck := NewDeck(WithJokers(tt.jokers))
+		if len(deck.Cards) != 52+len(tt.want) {
+			t.Errorf("WithJokers(%d): deck has %d cards, want %d", tt.jokers, len(deck.Cards), 52+len(tt.want))
+			continue
+		}
+		for i, joker := range tt.want {
+			if deck.Cards[52+i] != joker {
+				t.Errorf("WithJokers(%d): card %d = %v, want %v", tt.jokers, 52+i, deck.Cards[52+i], joker)
+			}
+		}
+		if deck.CardSet() != FullDeckSet {
+			t.Errorf("WithJokers(%d): CardSet() = %v, want the 52 regular cards", tt.jokers, deck.CardSet())
+		}
+	}
+
+	if short := NewShortDeck(WithJokers(1)); len(short.Cards) != 37 {
+		t.Errorf("short deck with a joker has %d cards, want 37", len(short.Cards))
+	}
+}
+
 // Test that Deal returns the correct number of cards
 func TestDealReturnsCorrectNumberOfCards(t *testing.T) {
 	deck := NewDeck()
@@ -114,3 +166,40 @@ func TestDealFiveFromFullDeckLeavesFortySevenCards(t *testing.T) {
 		t.Errorf("After Deal(5) from 52-card deck, should have 47 cards remaining, got %d", len(deck.Cards))
 	}
 }
+
+// Test that CardSet reflects the remaining cards after dealing
+func TestDeckCardSet(t *testing.T) {
+	deck := NewDeck()
+	if deck.CardSet() != FullDeckSet {
+		t.Errorf("NewDeck().CardSet() = %v, want full deck", deck.CardSet())
+	}
+
+	dealt, _ := deck.DealSet(5)
+	if dealt.Count() != 5 {
+		t.Errorf("DealSet(5) returned %d cards, want 5", dealt.Count())
+	}
+	if deck.CardSet() != FullDeckSet.Difference(dealt) {
+		t.Error("CardSet after DealSet should exclude the dealt cards")
+	}
+	if _, err := deck.DealSet(48); err == nil {
+		t.Error("DealSet(48) should return error when deck has only 47 cards")
+	}
+}
+
+// Test that Remove takes known cards out and keeps the remaining order
+func TestDeckRemove(t *testing.T) {
+	deck := NewDeck()
+	known, _ := NewCardSet([]Card{{Rank: Two, Suit: Hearts}, {Rank: Ace, Suit: Spades}})
+
+	deck.Remove(known)
+
+	if len(deck.Cards) != 50 {
+		t.Errorf("After Remove, deck should have 50 cards, got %d", len(deck.Cards))
+	}
+	if deck.Cards[0] != (Card{Rank: Three, Suit: Hearts}) {
+		t.Errorf("First card after Remove = %v, want 3h", deck.Cards[0])
+	}
+	if deck.CardSet().Intersect(known) != 0 {
+		t.Error("Removed cards should no longer be in the deck")
+	}
+}
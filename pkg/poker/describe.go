@@ -0,0 +1,225 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// DescriptionForm selects how much detail Describe includes.
+type DescriptionForm int
+
+const (
+	LongForm  DescriptionForm = iota // Every rank that can decide a comparison, kickers included
+	ShortForm                        // The made hand only, without kickers
+)
+
+// Locale is a table of words and phrase formats used to describe hands in a
+// language. Rank and category names missing from a table fall back to
+// Rank.String and HandCategory.String, and empty formats and RankJoin fall
+// back to English. Each format takes %s arguments as noted on its field.
+type Locale struct {
+	Name       string
+	Categories map[HandCategory]string
+	Ranks      map[Rank]string // Singular names, such as "King"
+	Plurals    map[Rank]string // Plural names, such as "Kings" or "Sixes"
+	Indefinite map[Rank]string // Singular with an article, such as "an Ace"; defaults to Ranks
+
+	NoHand      string // Description of a nil hand
+	Detail      string // Category and detail: "%s, %s"
+	High        string // Top rank or ranks of a straight or flush: "%s high"
+	FullOf      string // Trips and pair ranks of a full house: "%s full of %s"
+	And         string // The two pairs of two pair: "%s and %s"
+	WithKicker  string // Made hand and one kicker (from Indefinite): "%s with %s kicker"
+	WithKickers string // Made hand and kickers joined by RankJoin: "%s with %s kickers"
+	RankJoin    string // Separator between listed ranks: "-"
+}
+
+// English describes hands in English, for example
+// "Two Pair, Aces and Nines with a King kicker".
+var English = &Locale{
+	Name: "English",
+	Categories: map[HandCategory]string{
+		HighCard:      "High Card",
+		OnePair:       "One Pair",
+		TwoPair:       "Two Pair",
+		ThreeOfAKind:  "Three of a Kind",
+		Straight:      "Straight",
+		Flush:         "Flush",
+		FullHouse:     "Full House",
+		FourOfAKind:   "Four of a Kind",
+		StraightFlush: "Straight Flush",
+		RoyalFlush:    "Royal Flush",
+		FiveOfAKind:   "Five of a Kind",
+	},
+	Ranks: map[Rank]string{
+		Two: "Two", Three: "Three", Four: "Four", Five: "Five", Six: "Six",
+		Seven: "Seven", Eight: "Eight", Nine: "Nine", Ten: "Ten",
+		Jack: "Jack", Queen: "Queen", King: "King", Ace: "Ace",
+	},
+	Plurals: map[Rank]string{
+		Two: "Twos", Three: "Threes", Four: "Fours", Five: "Fives", Six: "Sixes",
+		Seven: "Sevens", Eight: "Eights", Nine: "Nines", Ten: "Tens",
+		Jack: "Jacks", Queen: "Queens", King: "Kings", Ace: "Aces",
+	},
+	Indefinite: map[Rank]string{
+		Two: "a Two", Three: "a Three", Four: "a Four", Five: "a Five", Six: "a Six",
+		Seven: "a Seven", Eight: "an Eight", Nine: "a Nine", Ten: "a Ten",
+		Jack: "a Jack", Queen: "a Queen", King: "a King", Ace: "an Ace",
+	},
+	NoHand:      "No Hand",
+	Detail:      "%s, %s",
+	High:        "%s high",
+	FullOf:      "%s full of %s",
+	And:         "%s and %s",
+	WithKicker:  "%s with %s kicker",
+	WithKickers: "%s with %s kickers",
+	RankJoin:    "-",
+}
+
+// German describes hands in German, for example
+// "Zwei Paare, Asse und Neunen, Kicker König".
+var German = &Locale{
+	Name: "Deutsch",
+	Categories: map[HandCategory]string{
+		HighCard:      "Höchste Karte",
+		OnePair:       "Ein Paar",
+		TwoPair:       "Zwei Paare",
+		ThreeOfAKind:  "Drilling",
+		Straight:      "Straße",
+		Flush:         "Flush",
+		FullHouse:     "Full House",
+		FourOfAKind:   "Vierling",
+		StraightFlush: "Straight Flush",
+		RoyalFlush:    "Royal Flush",
+		FiveOfAKind:   "Fünfling",
+	},
+	Ranks: map[Rank]string{
+		Two: "Zwei", Three: "Drei", Four: "Vier", Five: "Fünf", Six: "Sechs",
+		Seven: "Sieben", Eight: "Acht", Nine: "Neun", Ten: "Zehn",
+		Jack: "Bube", Queen: "Dame", King: "König", Ace: "Ass",
+	},
+	Plurals: map[Rank]string{
+		Two: "Zweien", Three: "Dreien", Four: "Vieren", Five: "Fünfen", Six: "Sechsen",
+		Seven: "Siebenen", Eight: "Achten", Nine: "Neunen", Ten: "Zehnen",
+		Jack: "Buben", Queen: "Damen", King: "Könige", Ace: "Asse",
+	},
+	NoHand:      "Keine Hand",
+	Detail:      "%s, %s",
+	High:        "%s hoch",
+	FullOf:      "%s über %s",
+	And:         "%s und %s",
+	WithKicker:  "%s, Kicker %s",
+	WithKickers: "%s, Kicker %s",
+	RankJoin:    "-",
+}
+
+// category returns the localized category name.
+func (l *Locale) category(c HandCategory) string {
+	if name, ok := l.Categories[c]; ok {
+		return name
+	}
+	return c.String()
+}
+
+// rank returns the localized singular rank name.
+func (l *Locale) rank(r Rank) string {
+	if name, ok := l.Ranks[r]; ok {
+		return name
+	}
+	return r.String()
+}
+
+// plural returns the localized plural rank name.
+func (l *Locale) plural(r Rank) string {
+	if name, ok := l.Plurals[r]; ok {
+		return name
+	}
+	return l.rank(r)
+}
+
+// indefinite returns the localized rank name with an indefinite article.
+func (l *Locale) indefinite(r Rank) string {
+	if name, ok := l.Indefinite[r]; ok {
+		return name
+	}
+	return l.rank(r)
+}
+
+// ranks joins singular rank names, as in "Ace-Queen-Nine".
+func (l *Locale) ranks(ranks []Rank) string {
+	names := make([]string, len(ranks))
+	for i, r := range ranks {
+		names[i] = l.rank(r)
+	}
+	return strings.Join(names, cmp.Or(l.RankJoin, English.RankJoin))
+}
+
+// withKickers appends kickers to a made-hand description. Short
+// descriptions and hands without kickers are returned unchanged.
+func (l *Locale) withKickers(made string, kickers []Rank, form DescriptionForm) string {
+	switch {
+	case form == ShortForm || len(kickers) == 0:
+		return made
+	case len(kickers) == 1:
+		return fmt.Sprintf(cmp.Or(l.WithKicker, English.WithKicker), made, l.indefinite(kickers[0]))
+	default:
+		return fmt.Sprintf(cmp.Or(l.WithKickers, English.WithKickers), made, l.ranks(kickers))
+	}
+}
+
+// Describe returns a description of hand built from its Category and
+// Tiebreakers, such as "Full House, Kings full of Sevens" or
+// "Flush, Ace-Queen high". The long form adds kickers and, for flushes and
+// high cards, every rank; the short form gives the made hand only.
+// Partial hands from EvaluatePartialHand list only the kickers they have.
+func (l *Locale) Describe(hand *Hand, form DescriptionForm) string {
+	if hand == nil {
+		return cmp.Or(l.NoHand, English.NoHand)
+	}
+
+	name := l.category(hand.Category)
+	tb := hand.Tiebreakers
+	if len(tb) == 0 {
+		return name
+	}
+
+	var detail string
+	switch hand.Category {
+	case RoyalFlush:
+		return name
+	case HighCard:
+		detail = l.rank(tb[0])
+		if form == LongForm {
+			detail = l.ranks(tb)
+		}
+	case Flush:
+		top := tb[:min(2, len(tb))]
+		if form == LongForm {
+			top = tb
+		}
+		detail = fmt.Sprintf(cmp.Or(l.High, English.High), l.ranks(top))
+	case Straight, StraightFlush:
+		detail = fmt.Sprintf(cmp.Or(l.High, English.High), l.rank(tb[0]))
+	case FullHouse:
+		detail = fmt.Sprintf(cmp.Or(l.FullOf, English.FullOf), l.plural(tb[0]), l.plural(tb[1]))
+	case TwoPair:
+		detail = l.withKickers(fmt.Sprintf(cmp.Or(l.And, English.And), l.plural(tb[0]), l.plural(tb[1])), tb[2:], form)
+	default:
+		// One Pair, Three of a Kind, Four of a Kind and Five of a Kind
+		detail = l.withKickers(l.plural(tb[0]), tb[1:], form)
+	}
+	return fmt.Sprintf(cmp.Or(l.Detail, English.Detail), name, detail)
+}
+
+// Describe returns the long English description of the hand, such as
+// "Two Pair, Aces and Nines with a King kicker". See Locale.Describe.
+func (h *Hand) Describe() string {
+	return English.Describe(h, LongForm)
+}
+
+// DescribeShort returns the short English description of the hand, such as
+// "Two Pair, Aces and Nines". See Locale.Describe.
+func (h *Hand) DescribeShort() string {
+	return English.Describe(h, ShortForm)
+}
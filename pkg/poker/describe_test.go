@@ -0,0 +1,100 @@
+package poker
+
+import "testing"
+
+// TestDescribe verifies long and short English descriptions for every
+// category, including plurals and kicker articles.
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+		long  string
+		short string
+	}{
+		{"high card", []string{"Ah", "Kd", "9c", "6s", "4h"}, "High Card, Ace-King-Nine-Six-Four", "High Card, Ace"},
+		{"one pair", []string{"Kh", "Kd", "Ac", "9s", "4h"}, "One Pair, Kings with Ace-Nine-Four kickers", "One Pair, Kings"},
+		{"pair of sixes", []string{"6h", "6d", "Ac", "9s", "4h"}, "One Pair, Sixes with Ace-Nine-Four kickers", "One Pair, Sixes"},
+		{"two pair", []string{"Ah", "Ad", "9c", "9s", "Kh"}, "Two Pair, Aces and Nines with a King kicker", "Two Pair, Aces and Nines"},
+		{"an ace kicker", []string{"Qh", "Qd", "3c", "3s", "Ah"}, "Two Pair, Queens and Threes with an Ace kicker", "Two Pair, Queens and Threes"},
+		{"an eight kicker", []string{"Qh", "Qd", "3c", "3s", "8h"}, "Two Pair, Queens and Threes with an Eight kicker", "Two Pair, Queens and Threes"},
+		{"three of a kind", []string{"7h", "7d", "7c", "As", "2h"}, "Three of a Kind, Sevens with Ace-Two kickers", "Three of a Kind, Sevens"},
+		{"straight", []string{"9h", "Td", "Jc", "Qs", "Kh"}, "Straight, King high", "Straight, King high"},
+		{"wheel", []string{"Ah", "2d", "3c", "4s", "5h"}, "Straight, Five high", "Straight, Five high"},
+		{"flush", []string{"Ah", "Qh", "9h", "6h", "4h"}, "Flush, Ace-Queen-Nine-Six-Four high", "Flush, Ace-Queen high"},
+		{"full house", []string{"Kh", "Kd", "Kc", "7s", "7h"}, "Full House, Kings full of Sevens", "Full House, Kings full of Sevens"},
+		{"four of a kind", []string{"9h", "9d", "9c", "9s", "Kh"}, "Four of a Kind, Nines with a King kicker", "Four of a Kind, Nines"},
+		{"straight flush", []string{"5s", "6s", "7s", "8s", "9s"}, "Straight Flush, Nine high", "Straight Flush, Nine high"},
+		{"royal flush", []string{"Ah", "Kh", "Qh", "Jh", "Th"}, "Royal Flush", "Royal Flush"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := EvaluateHand(mustParseCards(t, tt.cards...))
+			if got := hand.Describe(); got != tt.long {
+				t.Errorf("Describe() = %q, want %q", got, tt.long)
+			}
+			if got := hand.DescribeShort(); got != tt.short {
+				t.Errorf("DescribeShort() = %q, want %q", got, tt.short)
+			}
+		})
+	}
+}
+
+// TestDescribeSpecialHands verifies descriptions of nil, partial and wild
+// hands.
+func TestDescribeSpecialHands(t *testing.T) {
+	tests := []struct {
+		name string
+		hand *Hand
+		want string
+	}{
+		{"nil hand", nil, "No Hand"},
+		{"pocket pair", EvaluatePartialHand(mustParseCards(t, "Jh", "Jd")), "One Pair, Jacks"},
+		{"partial pair with kicker", EvaluatePartialHand(mustParseCards(t, "Th", "Td", "Ac")), "One Pair, Tens with an Ace kicker"},
+		{"partial two pair", EvaluatePartialHand(mustParseCards(t, "Th", "Td", "2c", "2s")), "Two Pair, Tens and Twos"},
+		{"five of a kind", EvaluateWildHand(mustParseCards(t, "Ah", "Ad", "Ac", "As", "Jr"), WildRules{}), "Five of a Kind, Aces"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hand.Describe(); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLocaleDescribe verifies the German table and fallbacks for custom
+// locales with missing names and formats.
+func TestLocaleDescribe(t *testing.T) {
+	twoPair := EvaluateHand(mustParseCards(t, "Ah", "Ad", "9c", "9s", "Kh"))
+	fullHouse := EvaluateHand(mustParseCards(t, "Kh", "Kd", "Kc", "7s", "7h"))
+	flush := EvaluateHand(mustParseCards(t, "Ah", "Qh", "9h", "6h", "4h"))
+	partial := &Locale{Categories: map[HandCategory]string{TwoPair: "Deux Paires"}}
+
+	tests := []struct {
+		name   string
+		locale *Locale
+		hand   *Hand
+		form   DescriptionForm
+		want   string
+	}{
+		{"german two pair", German, twoPair, LongForm, "Zwei Paare, Asse und Neunen, Kicker König"},
+		{"german two pair short", German, twoPair, ShortForm, "Zwei Paare, Asse und Neunen"},
+		{"german full house", German, fullHouse, LongForm, "Full House, Könige über Siebenen"},
+		{"german flush", German, flush, ShortForm, "Flush, Ass-Dame hoch"},
+		{"german nil", German, nil, LongForm, "Keine Hand"},
+		{"empty tables fall back", &Locale{Detail: "%s: %s", FullOf: "%s/%s"}, fullHouse, LongForm, "Full House: K/7"},
+		{"empty formats fall back", partial, twoPair, LongForm, "Deux Paires, A and 9 with K kicker"},
+		{"empty high and join fall back", partial, flush, LongForm, "Flush, A-Q-9-6-4 high"},
+		{"empty no hand falls back", partial, nil, LongForm, "No Hand"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.Describe(tt.hand, tt.form); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
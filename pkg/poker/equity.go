@@ -0,0 +1,378 @@
+package poker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// DefaultEquityIterations is the number of random runouts CalculateEquity
+// uses when EquityOptions.Iterations is zero.
+const DefaultEquityIterations = 100000
+
+// DefaultExactThreshold is the largest number of remaining boards that
+// EquityAuto enumerates exactly when EquityOptions.ExactThreshold is zero.
+// It covers heads-up preflop, which has 1,712,304 possible boards.
+const DefaultExactThreshold = 2000000
+
+// cancelCheckInterval is how many runouts are evaluated between checks of
+// the context for cancellation.
+const cancelCheckInterval = 1024
+
+// EquityMode selects how equity is computed.
+type EquityMode int
+
+const (
+	EquityAuto       EquityMode = iota // Enumerate when the board count is at most the threshold, otherwise sample
+	EquityMonteCarlo                   // Always sample random runouts
+	EquityExact                        // Always enumerate every remaining board
+)
+
+// EquityOptions configures an equity calculation.
+type EquityOptions struct {
+	Board          []Card     // Known community cards (0 to 5)
+	Dead           []Card     // Cards known to be out of play (mucked or exposed)
+	Mode           EquityMode // Sampling or enumeration; EquityAuto by default
+	ExactThreshold int64      // Most boards EquityAuto enumerates; DefaultExactThreshold if 0
+	Iterations     int        // Random runouts to sample; DefaultEquityIterations if 0
+	Seed           uint64     // Seed for reproducible runouts; 0 picks a random seed
+}
+
+// PlayerEquity summarizes one player's results over all runouts.
+type PlayerEquity struct {
+	Wins   int64   // Runouts won outright
+	Ties   int64   // Runouts in which the pot was split with other players
+	Losses int64   // Runouts lost
+	Win    float64 // Fraction of runouts won outright
+	Tie    float64 // Fraction of runouts split
+	Loss   float64 // Fraction of runouts lost
+	Equity float64 // Average share of the pot won, from 0 to 1
+	StdErr float64 // Standard error of Equity (0 for exact results)
+}
+
+// EquityResult holds per-player equity, in the same order as the hands passed in.
+type EquityResult struct {
+	Players []PlayerEquity
+	Runouts int64 // Number of boards evaluated
+	Exact   bool  // True if every possible board was enumerated
+}
+
+// equityTally accumulates showdown outcomes across runouts. Each runout
+// carries a weight (1 unless combos are weighted, as in range equity);
+// counts are unweighted while fractions and equity are weighted.
+type equityTally struct {
+	wins, ties, losses []int64
+	winWeight          []float64
+	tieWeight          []float64
+	lossWeight         []float64
+	share, shareSq     []float64 // Weighted sums of each player's pot share
+	runouts            int64
+	weight             float64 // Total weight of all runouts
+}
+
+// newEquityTally creates a tally for the given number of players.
+func newEquityTally(players int) *equityTally {
+	return &equityTally{
+		wins:       make([]int64, players),
+		ties:       make([]int64, players),
+		losses:     make([]int64, players),
+		winWeight:  make([]float64, players),
+		tieWeight:  make([]float64, players),
+		lossWeight: make([]float64, players),
+		share:      make([]float64, players),
+		shareSq:    make([]float64, players),
+	}
+}
+
+// splitShares sets shares[i] to player i's fraction of the pot for one
+// runout, given each player's hand rank.
+func splitShares(ranks []HandRank, shares []float64) {
+	var best HandRank
+	winners := 0
+	for _, r := range ranks {
+		if r > best {
+			best, winners = r, 1
+		} else if r == best {
+			winners++
+		}
+	}
+
+	for i, r := range ranks {
+		if r == best {
+			shares[i] = 1 / float64(winners)
+		} else {
+			shares[i] = 0
+		}
+	}
+}
+
+// record scores one runout from each player's pot share.
+func (t *equityTally) record(shares []float64, weight float64) {
+	for i, share := range shares {
+		switch {
+		case share == 0:
+			t.losses[i]++
+			t.lossWeight[i] += weight
+		case share == 1:
+			t.wins[i]++
+			t.winWeight[i] += weight
+		default:
+			t.ties[i]++
+			t.tieWeight[i] += weight
+		}
+		t.share[i] += weight * share
+		t.shareSq[i] += weight * share * share
+	}
+	t.runouts++
+	t.weight += weight
+}
+
+// merge adds another tally's outcomes into t.
+func (t *equityTally) merge(other *equityTally) {
+	for i := range t.wins {
+		t.wins[i] += other.wins[i]
+		t.ties[i] += other.ties[i]
+		t.losses[i] += other.losses[i]
+		t.winWeight[i] += other.winWeight[i]
+		t.tieWeight[i] += other.tieWeight[i]
+		t.lossWeight[i] += other.lossWeight[i]
+		t.share[i] += other.share[i]
+		t.shareSq[i] += other.shareSq[i]
+	}
+	t.runouts += other.runouts
+	t.weight += other.weight
+}
+
+// result converts the tally to an EquityResult. The standard error assumes
+// unweighted samples, which holds for every sampled calculation.
+func (t *equityTally) result(exact bool) *EquityResult {
+	res := &EquityResult{
+		Players: make([]PlayerEquity, len(t.wins)),
+		Runouts: t.runouts,
+		Exact:   exact,
+	}
+	if t.runouts == 0 || t.weight == 0 {
+		return res
+	}
+
+	n := float64(t.runouts)
+	for i := range res.Players {
+		p := &res.Players[i]
+		p.Wins, p.Ties, p.Losses = t.wins[i], t.ties[i], t.losses[i]
+		p.Win = t.winWeight[i] / t.weight
+		p.Tie = t.tieWeight[i] / t.weight
+		p.Loss = t.lossWeight[i] / t.weight
+		p.Equity = t.share[i] / t.weight
+		if !exact && t.runouts > 1 {
+			variance := (t.shareSq[i]/t.weight - p.Equity*p.Equity) * n / (n - 1)
+			p.StdErr = math.Sqrt(math.Max(variance, 0) / n)
+		}
+	}
+	return res
+}
+
+// equitySetup is the validated card layout for an equity calculation.
+type equitySetup struct {
+	holes     []CardSet
+	board     CardSet
+	remaining CardSet // Cards that can still be dealt to the board
+	need      int     // Board cards still to come
+}
+
+// cardTracker checks that no card is used twice across groups of cards.
+type cardTracker struct {
+	used CardSet
+}
+
+// add validates a group of cards and marks them as used.
+func (t *cardTracker) add(cards []Card, what string) (CardSet, error) {
+	set, err := NewCardSet(cards)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", what, err)
+	}
+	if overlap := t.used.Intersect(set); overlap != 0 {
+		return 0, fmt.Errorf("%s: card %v is already in use", what, overlap.Cards()[0])
+	}
+	t.used = t.used.Union(set)
+	return set, nil
+}
+
+// newEquitySetup validates hole cards, board and dead cards for Hold'em.
+// Every player must hold exactly 2 cards and no card may appear twice.
+func newEquitySetup(hands [][]Card, board, dead []Card) (*equitySetup, error) {
+	if len(hands) < 2 {
+		return nil, fmt.Errorf("equity needs at least 2 players, got %d", len(hands))
+	}
+	if len(board) > 5 {
+		return nil, fmt.Errorf("board must contain at most 5 cards, got %d", len(board))
+	}
+
+	var tracker cardTracker
+	setup := &equitySetup{holes: make([]CardSet, len(hands))}
+	for i, hand := range hands {
+		if len(hand) != 2 {
+			return nil, fmt.Errorf("player %d must have exactly 2 hole cards, got %d", i+1, len(hand))
+		}
+		set, err := tracker.add(hand, fmt.Sprintf("player %d", i+1))
+		if err != nil {
+			return nil, err
+		}
+		setup.holes[i] = set
+	}
+
+	var err error
+	if setup.board, err = tracker.add(board, "board"); err != nil {
+		return nil, err
+	}
+	if _, err = tracker.add(dead, "dead cards"); err != nil {
+		return nil, err
+	}
+
+	setup.remaining = FullDeckSet.Difference(tracker.used)
+	setup.need = 5 - len(board)
+	if setup.remaining.Count() < setup.need {
+		return nil, fmt.Errorf("cannot complete the board: need %d cards, only %d available", setup.need, setup.remaining.Count())
+	}
+	return setup, nil
+}
+
+// boards returns the number of distinct ways to complete the board.
+func (s *equitySetup) boards() int64 {
+	return binomial(s.remaining.Count(), s.need)
+}
+
+// exact reports whether the options call for enumerating every board.
+func (s *equitySetup) exact(opts EquityOptions) bool {
+	switch opts.Mode {
+	case EquityExact:
+		return true
+	case EquityMonteCarlo:
+		return false
+	}
+	threshold := opts.ExactThreshold
+	if threshold <= 0 {
+		threshold = DefaultExactThreshold
+	}
+	return s.boards() <= threshold
+}
+
+// binomial returns the number of k-element subsets of an n-element set.
+func binomial(n, k int) int64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := int64(1)
+	for i := 1; i <= k; i++ {
+		result = result * int64(n-k+i) / int64(i)
+	}
+	return result
+}
+
+// evaluate scores one complete board for every player into ranks.
+func (s *equitySetup) evaluate(board CardSet, ranks []HandRank) {
+	for i, hole := range s.holes {
+		ranks[i] = EvaluateCardSet(hole | board)
+	}
+}
+
+// sampler draws random board completions from the remaining cards.
+type sampler struct {
+	src   rand.Source
+	cards [NumCards]CardSet
+	n     int
+}
+
+// newSampler prepares to draw from the cards in remaining.
+func newSampler(remaining CardSet, src rand.Source) *sampler {
+	s := &sampler{src: src}
+	for i := range remaining.All() {
+		s.cards[s.n] = CardSet(1) << i
+		s.n++
+	}
+	return s
+}
+
+// draw returns k distinct random cards using a partial Fisher-Yates shuffle.
+func (s *sampler) draw(k int) CardSet {
+	var drawn CardSet
+	for i := 0; i < k; i++ {
+		j := i + int(uniformBelow(s.src, uint64(s.n-i)))
+		s.cards[i], s.cards[j] = s.cards[j], s.cards[i]
+		drawn |= s.cards[i]
+	}
+	return drawn
+}
+
+// equitySource returns the seeded source for a calculation, drawing a random
+// seed from CryptoSource when seed is 0.
+func equitySource(seed uint64) rand.Source {
+	if seed == 0 {
+		seed = CryptoSource{}.Uint64()
+	}
+	return NewSeededSource(seed)
+}
+
+// CalculateEquity computes each player's Hold'em equity. hands holds each
+// player's 2 hole cards. Depending on opts.Mode it either enumerates every
+// remaining board for an exact result or samples random runouts; the default
+// EquityAuto enumerates whenever there are at most opts.ExactThreshold boards.
+// Sampled results are reproducible for a fixed opts.Seed. If ctx is cancelled,
+// the runouts completed so far are returned together with ctx.Err().
+func CalculateEquity(ctx context.Context, hands [][]Card, opts EquityOptions) (*EquityResult, error) {
+	setup, err := newEquitySetup(hands, opts.Board, opts.Dead)
+	if err != nil {
+		return nil, err
+	}
+
+	if setup.exact(opts) {
+		return enumerateEquity(ctx, setup)
+	}
+	return sampleEquity(ctx, setup, opts)
+}
+
+// enumerateEquity evaluates every remaining board exactly once.
+func enumerateEquity(ctx context.Context, setup *equitySetup) (*EquityResult, error) {
+	tally := newEquityTally(len(setup.holes))
+	ranks := make([]HandRank, len(setup.holes))
+	shares := make([]float64, len(setup.holes))
+
+	for runout := range CombinationsOfSet(setup.remaining, setup.need) {
+		if tally.runouts%cancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return tally.result(false), err
+			}
+		}
+		setup.evaluate(setup.board|runout, ranks)
+		splitShares(ranks, shares)
+		tally.record(shares, 1)
+	}
+
+	return tally.result(true), nil
+}
+
+// sampleEquity evaluates opts.Iterations random boards.
+func sampleEquity(ctx context.Context, setup *equitySetup, opts EquityOptions) (*EquityResult, error) {
+	iterations := opts.Iterations
+	if iterations <= 0 {
+		iterations = DefaultEquityIterations
+	}
+
+	tally := newEquityTally(len(setup.holes))
+	ranks := make([]HandRank, len(setup.holes))
+	shares := make([]float64, len(setup.holes))
+	draws := newSampler(setup.remaining, equitySource(opts.Seed))
+
+	for i := 0; i < iterations; i++ {
+		if i%cancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return tally.result(false), err
+			}
+		}
+		setup.evaluate(setup.board|draws.draw(setup.need), ranks)
+		splitShares(ranks, shares)
+		tally.record(shares, 1)
+	}
+
+	return tally.result(false), nil
+}
@@ -0,0 +1,343 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestCalculateEquityPreflopCoinFlip checks a well-known preflop matchup:
+// AhKh against QsQd is close to 46% to 54%.
+func TestCalculateEquityPreflopCoinFlip(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+	}
+
+	result, err := CalculateEquity(context.Background(), hands, EquityOptions{Mode: EquityMonteCarlo, Iterations: 50000, Seed: 1})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+
+	if result.Runouts != 50000 || result.Exact {
+		t.Errorf("Runouts = %d, Exact = %v, want 50000 sampled runouts", result.Runouts, result.Exact)
+	}
+
+	ak, qq := result.Players[0], result.Players[1]
+	if math.Abs(ak.Equity-0.46) > 0.015 {
+		t.Errorf("AhKh equity = %.4f, want about 0.46", ak.Equity)
+	}
+	if math.Abs(ak.Equity+qq.Equity-1) > 1e-9 {
+		t.Errorf("equities sum to %.6f, want 1", ak.Equity+qq.Equity)
+	}
+	if ak.StdErr <= 0 || ak.StdErr > 0.005 {
+		t.Errorf("AhKh StdErr = %.5f, want a small positive value", ak.StdErr)
+	}
+	for i, p := range result.Players {
+		if p.Wins+p.Ties+p.Losses != result.Runouts {
+			t.Errorf("player %d: wins+ties+losses = %d, want %d", i+1, p.Wins+p.Ties+p.Losses, result.Runouts)
+		}
+		if math.Abs(p.Win+p.Tie+p.Loss-1) > 1e-9 {
+			t.Errorf("player %d: fractions sum to %.6f, want 1", i+1, p.Win+p.Tie+p.Loss)
+		}
+	}
+}
+
+// TestCalculateEquityCompleteBoard verifies that a full board has one
+// deterministic outcome, including a split pot.
+func TestCalculateEquityCompleteBoard(t *testing.T) {
+	tests := []struct {
+		name   string
+		hands  [][]string
+		board  []string
+		equity []float64
+	}{
+		{
+			"set beats overpair",
+			[][]string{{"7h", "7d"}, {"Ah", "Ad"}},
+			[]string{"7c", "Ks", "2d", "9h", "3c"},
+			[]float64{1, 0},
+		},
+		{
+			"board plays for a three-way split",
+			[][]string{{"2h", "3d"}, {"4c", "5c"}, {"2s", "3s"}},
+			[]string{"Ah", "Kd", "Qc", "Js", "Th"},
+			[]float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hands := make([][]Card, len(tt.hands))
+			for i, h := range tt.hands {
+				hands[i] = mustParseCards(t, h...)
+			}
+
+			result, err := CalculateEquity(context.Background(), hands, EquityOptions{
+				Board:      mustParseCards(t, tt.board...),
+				Iterations: 100,
+				Seed:       3,
+			})
+			if err != nil {
+				t.Fatalf("CalculateEquity returned error: %v", err)
+			}
+
+			for i, want := range tt.equity {
+				if math.Abs(result.Players[i].Equity-want) > 1e-9 {
+					t.Errorf("player %d equity = %.4f, want %.4f", i+1, result.Players[i].Equity, want)
+				}
+				if result.Players[i].StdErr > 1e-6 {
+					t.Errorf("player %d StdErr = %.4f, want 0 for a fixed outcome", i+1, result.Players[i].StdErr)
+				}
+			}
+		})
+	}
+}
+
+// TestCalculateEquitySeedIsReproducible verifies that a seed fixes the result.
+func TestCalculateEquitySeedIsReproducible(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+		mustParseCards(t, "9c", "8c"),
+	}
+	opts := EquityOptions{
+		Board:      mustParseCards(t, "Qh", "Th", "2c"),
+		Dead:       mustParseCards(t, "Jh"),
+		Mode:       EquityMonteCarlo,
+		Iterations: 5000,
+		Seed:       99,
+	}
+
+	first, err := CalculateEquity(context.Background(), hands, opts)
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+	second, _ := CalculateEquity(context.Background(), hands, opts)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Error("CalculateEquity with the same seed should return identical results")
+	}
+}
+
+// TestCalculateEquityDeadCardsAreExcluded verifies that dead cards never
+// reach the board: with both remaining kings dead, KK cannot improve to a set.
+func TestCalculateEquityDeadCardsAreExcluded(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Kh", "Kd"),
+		mustParseCards(t, "Ah", "Ad"),
+	}
+
+	result, err := CalculateEquity(context.Background(), hands, EquityOptions{
+		Board:      mustParseCards(t, "7c", "4s", "2d", "9s"),
+		Dead:       mustParseCards(t, "Kc", "Ks"),
+		Iterations: 2000,
+		Seed:       5,
+	})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+
+	if result.Players[0].Wins != 0 {
+		t.Errorf("KK won %d runouts, want 0 with both kings dead", result.Players[0].Wins)
+	}
+}
+
+// TestCalculateEquityCancelled verifies that a cancelled context stops the run.
+func TestCalculateEquityCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+	}
+
+	result, err := CalculateEquity(ctx, hands, EquityOptions{Mode: EquityMonteCarlo, Iterations: 1000000, Seed: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if result == nil || result.Runouts != 0 {
+		t.Errorf("result = %+v, want an empty partial result", result)
+	}
+}
+
+// TestCalculateEquityInvalidInput verifies input validation.
+func TestCalculateEquityInvalidInput(t *testing.T) {
+	ak := mustParseCards(t, "Ah", "Kh")
+	qq := mustParseCards(t, "Qs", "Qd")
+
+	tests := []struct {
+		name  string
+		hands [][]Card
+		opts  EquityOptions
+	}{
+		{"single player", [][]Card{ak}, EquityOptions{}},
+		{"three hole cards", [][]Card{ak, mustParseCards(t, "Qs", "Qd", "Qc")}, EquityOptions{}},
+		{"shared hole card", [][]Card{ak, mustParseCards(t, "Ah", "Qd")}, EquityOptions{}},
+		{"board overlaps hand", [][]Card{ak, qq}, EquityOptions{Board: mustParseCards(t, "Kh", "2c", "3d")}},
+		{"dead card overlaps board", [][]Card{ak, qq}, EquityOptions{Board: mustParseCards(t, "2c", "3d", "4s"), Dead: mustParseCards(t, "2c")}},
+		{"six board cards", [][]Card{ak, qq}, EquityOptions{Board: mustParseCards(t, "2c", "3d", "4s", "5h", "7c", "8c")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CalculateEquity(context.Background(), tt.hands, tt.opts); err == nil {
+				t.Error("CalculateEquity should return an error")
+			}
+		})
+	}
+}
+
+// TestCalculateEquityExactMatchesBruteForce compares exact enumeration on the
+// flop with a direct FindBestHand/CompareHands walk over every turn and river.
+func TestCalculateEquityExactMatchesBruteForce(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+		mustParseCards(t, "Jc", "Tc"),
+	}
+	board := mustParseCards(t, "Qh", "9c", "2h")
+	dead := mustParseCards(t, "3s")
+
+	result, err := CalculateEquity(context.Background(), hands, EquityOptions{Board: board, Dead: dead, Mode: EquityExact})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+	if !result.Exact {
+		t.Error("Exact = false, want true")
+	}
+
+	var used []Card
+	used = append(used, board...)
+	used = append(used, dead...)
+	for _, hole := range hands {
+		used = append(used, hole...)
+	}
+	var remaining []Card
+	for _, card := range NewDeck().Cards {
+		if !containsCard(used, card) {
+			remaining = append(remaining, card)
+		}
+	}
+
+	wins := make([]int64, len(hands))
+	ties := make([]int64, len(hands))
+	var boards int64
+	for _, runout := range Combinations(remaining, 2) {
+		boards++
+		best := make([]*Hand, len(hands))
+		for i, hole := range hands {
+			cards := append(append(append([]Card{}, hole...), board...), runout...)
+			best[i] = FindBestHand(cards)
+		}
+		var winners []int
+		for i := range best {
+			if len(winners) == 0 || CompareHands(best[i], best[winners[0]]) > 0 {
+				winners = []int{i}
+			} else if CompareHands(best[i], best[winners[0]]) == 0 {
+				winners = append(winners, i)
+			}
+		}
+		for _, w := range winners {
+			if len(winners) == 1 {
+				wins[w]++
+			} else {
+				ties[w]++
+			}
+		}
+	}
+
+	if result.Runouts != boards {
+		t.Errorf("Runouts = %d, want %d", result.Runouts, boards)
+	}
+	for i, p := range result.Players {
+		if p.Wins != wins[i] || p.Ties != ties[i] {
+			t.Errorf("player %d: wins/ties = %d/%d, want %d/%d", i+1, p.Wins, p.Ties, wins[i], ties[i])
+		}
+		if p.StdErr != 0 {
+			t.Errorf("player %d: StdErr = %f, want 0 for exact results", i+1, p.StdErr)
+		}
+	}
+}
+
+// TestCalculateEquityAutoMode verifies that EquityAuto enumerates small board
+// counts and samples when the count exceeds the threshold.
+func TestCalculateEquityAutoMode(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+	}
+	flop := mustParseCards(t, "2c", "7d", "Jh")
+
+	result, err := CalculateEquity(context.Background(), hands, EquityOptions{Board: flop})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+	if !result.Exact || result.Runouts != 990 {
+		t.Errorf("flop: Exact = %v, Runouts = %d, want exact with 990 boards", result.Exact, result.Runouts)
+	}
+
+	result, err = CalculateEquity(context.Background(), hands, EquityOptions{
+		Board:          flop,
+		ExactThreshold: 500,
+		Iterations:     2000,
+		Seed:           1,
+	})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+	if result.Exact || result.Runouts != 2000 {
+		t.Errorf("low threshold: Exact = %v, Runouts = %d, want 2000 sampled runouts", result.Exact, result.Runouts)
+	}
+}
+
+// TestCalculateEquityExactPreflop enumerates all 1,712,304 heads-up boards.
+func TestCalculateEquityExactPreflop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping full preflop enumeration in short mode")
+	}
+
+	hands := [][]Card{
+		mustParseCards(t, "Ah", "Kh"),
+		mustParseCards(t, "Qs", "Qd"),
+	}
+
+	result, err := CalculateEquity(context.Background(), hands, EquityOptions{})
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+	if !result.Exact || result.Runouts != 1712304 {
+		t.Fatalf("Exact = %v, Runouts = %d, want exact with 1712304 boards", result.Exact, result.Runouts)
+	}
+
+	ak, qq := result.Players[0], result.Players[1]
+	if ak.Wins+qq.Wins+ak.Ties != result.Runouts || ak.Ties != qq.Ties {
+		t.Errorf("inconsistent counts: AK %d/%d, QQ %d/%d", ak.Wins, ak.Ties, qq.Wins, qq.Ties)
+	}
+	if math.Abs(ak.Equity-0.46) > 0.01 {
+		t.Errorf("AhKh equity = %.4f, want about 0.46", ak.Equity)
+	}
+}
+
+// TestBinomial verifies board counts used to choose enumeration.
+func TestBinomial(t *testing.T) {
+	tests := []struct {
+		n, k int
+		want int64
+	}{
+		{48, 5, 1712304},
+		{47, 2, 1081},
+		{45, 1, 45},
+		{5, 0, 1},
+		{3, 4, 0},
+	}
+
+	for _, tt := range tests {
+		if got := binomial(tt.n, tt.k); got != tt.want {
+			t.Errorf("binomial(%d, %d) = %d, want %d", tt.n, tt.k, got, tt.want)
+		}
+	}
+}
@@ -416,10 +416,11 @@ func detectHighCard(cards []Card) (bool, []Rank) {
 
 // EvaluateHand evaluates a 5-card poker hand and returns the best hand category with tiebreakers.
 // Checks hand categories from strongest (Royal Flush) to weakest (High Card).
-// Returns nil if the input is not exactly 5 cards.
+// Returns nil if the input is not exactly 5 cards or a card is not one of
+// the 52 natural cards, such as a joker.
 // Optimized: precomputes rankCounts once and reuses it across detectors that need rank frequency data.
 func EvaluateHand(cards []Card) *Hand {
-	if len(cards) != 5 {
+	if len(cards) != 5 || !naturalCards(cards) {
 		return nil
 	}
 
@@ -519,16 +520,25 @@ func EvaluateHand(cards []Card) *Hand {
 // CompareHands compares two poker hands and returns:
 // 1 if hand1 is stronger, -1 if hand2 is stronger, 0 if equal.
 // Compares by category first, then by tiebreakers element-by-element.
+// Categories are ordered by the hands' RankingRules (hand1's if both are
+// set), so Short Deck hands compare correctly; standard rules apply when
+// neither hand has rules.
 func CompareHands(hand1, hand2 *Hand) int {
 	if hand1 == nil || hand2 == nil {
 		return 0
 	}
 
-	// Compare by category first (higher category wins)
-	if hand1.Category > hand2.Category {
+	rules := hand1.Rules
+	if rules == nil {
+		rules = hand2.Rules
+	}
+
+	// Compare by category first (stronger category wins)
+	strength1, strength2 := rules.strength(hand1.Category), rules.strength(hand2.Category)
+	if strength1 > strength2 {
 		return 1
 	}
-	if hand1.Category < hand2.Category {
+	if strength1 < strength2 {
 		return -1
 	}
 
@@ -552,8 +562,10 @@ func CompareHands(hand1, hand2 *Hand) int {
 }
 
 // FindBestHand finds the best 5-card poker hand from 5, 6, or 7 cards.
-// Generates all possible 5-card combinations, evaluates each, and returns the strongest.
-// Returns nil if fewer than 5 cards are provided.
+// For 6 or more cards it evaluates rank and suit bitmasks in a single pass
+// (see EvaluateBestHandRank) and reports the five winning cards.
+// Returns nil if fewer than 5 cards are provided or a card is not one of
+// the 52 natural cards, such as a joker.
 func FindBestHand(cards []Card) *Hand {
 	if len(cards) < 5 {
 		return nil
@@ -564,6 +576,23 @@ func FindBestHand(cards []Card) *Hand {
 		return EvaluateHand(cards)
 	}
 
+	suits, ok := cardSuitMasks(cards)
+	if !ok {
+		// Invalid or duplicate cards: fall back to evaluating every combination
+		return findBestHandByCombinations(cards)
+	}
+
+	best := evaluateSuitMasks(&suits)
+	return best.rank.Hand(best.pick(cards))
+}
+
+// findBestHandByCombinations generates all 5-card combinations, evaluates
+// each, and returns the strongest. Returns nil if a card is not natural.
+func findBestHandByCombinations(cards []Card) *Hand {
+	if !naturalCards(cards) {
+		return nil
+	}
+
 	// Generate all 5-card combinations
 	combinations := Combinations(cards, 5)
 
@@ -579,3 +608,14 @@ func FindBestHand(cards []Card) *Hand {
 
 	return bestHand
 }
+
+// naturalCards reports whether every card is one of the 52 standard cards,
+// so not a joker and with a rank from Two to Ace.
+func naturalCards(cards []Card) bool {
+	for _, card := range cards {
+		if !card.Index().Valid() {
+			return false
+		}
+	}
+	return true
+}
@@ -100,3 +100,80 @@ func BenchmarkCombinations(b *testing.B) {
 		_ = Combinations(cards, 5)
 	}
 }
+
+// BenchmarkEvaluateHandRank measures the lookup-table evaluator on the same
+// pair of kings used by BenchmarkEvaluateHand, for a direct comparison.
+func BenchmarkEvaluateHandRank(b *testing.B) {
+	cards := []Card{
+		{Rank: King, Suit: Hearts},
+		{Rank: King, Suit: Diamonds},
+		{Rank: Queen, Suit: Clubs},
+		{Rank: Jack, Suit: Spades},
+		{Rank: Nine, Suit: Hearts},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = EvaluateHandRank(cards)
+	}
+}
+
+// BenchmarkFindBestHandCombinations7Cards measures the previous approach of
+// evaluating all 21 five-card combinations, as a baseline for the
+// single-pass evaluator used by FindBestHand.
+func BenchmarkFindBestHandCombinations7Cards(b *testing.B) {
+	// Same three jacks hand as BenchmarkFindBestHand7Cards
+	cards := []Card{
+		{Rank: Jack, Suit: Hearts},
+		{Rank: Jack, Suit: Diamonds},
+		{Rank: Jack, Suit: Clubs},
+		{Rank: King, Suit: Spades},
+		{Rank: Queen, Suit: Hearts},
+		{Rank: Eight, Suit: Diamonds},
+		{Rank: Three, Suit: Clubs},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = findBestHandByCombinations(cards)
+	}
+}
+
+// BenchmarkEvaluateBestHandRank6Cards measures the allocation-free
+// single-pass evaluator with 6 cards.
+func BenchmarkEvaluateBestHandRank6Cards(b *testing.B) {
+	// Same straight hand as BenchmarkFindBestHand6Cards
+	cards := []Card{
+		{Rank: Nine, Suit: Hearts},
+		{Rank: Eight, Suit: Diamonds},
+		{Rank: Seven, Suit: Clubs},
+		{Rank: Six, Suit: Spades},
+		{Rank: Five, Suit: Hearts},
+		{Rank: Two, Suit: Diamonds},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = EvaluateBestHandRank(cards)
+	}
+}
+
+// BenchmarkEvaluateBestHandRank7Cards measures the allocation-free
+// single-pass evaluator with 7 cards.
+func BenchmarkEvaluateBestHandRank7Cards(b *testing.B) {
+	// Same three jacks hand as BenchmarkFindBestHand7Cards
+	cards := []Card{
+		{Rank: Jack, Suit: Hearts},
+		{Rank: Jack, Suit: Diamonds},
+		{Rank: Jack, Suit: Clubs},
+		{Rank: King, Suit: Spades},
+		{Rank: Queen, Suit: Hearts},
+		{Rank: Eight, Suit: Diamonds},
+		{Rank: Three, Suit: Clubs},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = EvaluateBestHandRank(cards)
+	}
+}
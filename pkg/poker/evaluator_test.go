@@ -2,6 +2,7 @@ package poker
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -1833,3 +1834,36 @@ func TestDetectOnePair_WithPrecomputedCounts(t *testing.T) {
 		}
 	}
 }
+
+// TestEvaluateHandInvalidCards verifies that jokers and ranks outside Two
+// to Ace are rejected rather than evaluated as natural cards.
+func TestEvaluateHandInvalidCards(t *testing.T) {
+	royal := mustParseCards(t, "Ah", "Kh", "Qh", "Jh")
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"red joker", append([]Card{RedJoker}, royal...)},
+		{"black joker", append(slices.Clone(royal), BlackJoker)},
+		{"rank above Ace", append(slices.Clone(royal), Card{Rank: 16, Suit: Hearts})},
+		{"rank below Two", append(slices.Clone(royal), Card{Rank: 1, Suit: Hearts})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if hand := EvaluateHand(tt.cards); hand != nil {
+				t.Errorf("EvaluateHand(%v) = %v, want nil", tt.cards, hand)
+			}
+			if hand := FindBestHand(tt.cards); hand != nil {
+				t.Errorf("FindBestHand(%v) = %v, want nil", tt.cards, hand)
+			}
+			withExtra := append(slices.Clone(tt.cards), Card{Rank: Two, Suit: Clubs})
+			if hand := FindBestHand(withExtra); hand != nil {
+				t.Errorf("FindBestHand(%v) = %v, want nil", withExtra, hand)
+			}
+			if hand := findBestHandByCombinations(withExtra); hand != nil {
+				t.Errorf("findBestHandByCombinations(%v) = %v, want nil", withExtra, hand)
+			}
+		})
+	}
+}
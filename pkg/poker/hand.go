@@ -17,6 +17,7 @@ const (
 	FourOfAKind   HandCategory = 8  // Four cards of the same rank
 	StraightFlush HandCategory = 9  // Straight with all cards the same suit
 	RoyalFlush    HandCategory = 10 // Ace-high straight flush (10-J-Q-K-A)
+	FiveOfAKind   HandCategory = 11 // Five cards of the same rank, only possible with wild cards
 )
 
 // String returns the human-readable name of the hand category.
@@ -42,6 +43,8 @@ func (hc HandCategory) String() string {
 		return "Straight Flush"
 	case RoyalFlush:
 		return "Royal Flush"
+	case FiveOfAKind:
+		return "Five of a Kind"
 	default:
 		return "Unknown"
 	}
@@ -51,9 +54,10 @@ func (hc HandCategory) String() string {
 // Tiebreakers are ranks in descending order of importance for comparing
 // hands of the same category.
 type Hand struct {
-	Cards       []Card       // The 5 cards in the hand
-	Category    HandCategory // The hand category (Royal Flush, etc.)
-	Tiebreakers []Rank       // Ranks for tiebreaker comparison
+	Cards       []Card        // The 5 cards in the hand
+	Category    HandCategory  // The hand category (Royal Flush, etc.)
+	Tiebreakers []Rank        // Ranks for tiebreaker comparison
+	Rules       *RankingRules // Variant ranking rules; nil means standard rules
 }
 
 // NewHand creates a new Hand from the given cards.
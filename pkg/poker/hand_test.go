@@ -105,6 +105,7 @@ func TestHandCategoryString(t *testing.T) {
 		{FourOfAKind, "Four of a Kind"},
 		{StraightFlush, "Straight Flush"},
 		{RoyalFlush, "Royal Flush"},
+		{FiveOfAKind, "Five of a Kind"},
 	}
 
 	for _, tt := range tests {
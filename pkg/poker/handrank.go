@@ -0,0 +1,257 @@
+package poker
+
+import "sort"
+
+// HandRank is the absolute strength of a 5-card poker hand, from 1 (7-5-4-3-2
+// offsuit, the weakest hand) to 7462 (a royal flush). Two hands compare the
+// same way by HandRank as they do with CompareHands, so ranks can be compared
+// with the ordinary integer operators. The zero value marks an invalid hand.
+type HandRank uint16
+
+// MaxHandRank is the rank of a royal flush, the strongest 5-card hand.
+// There are exactly 7462 distinct 5-card hand values.
+const MaxHandRank HandRank = 7462
+
+// rankPrimes maps each rank (indexed by Rank) to a distinct prime so that the
+// product of five card primes uniquely identifies a multiset of ranks.
+var rankPrimes = [15]uint32{
+	Two: 2, Three: 3, Four: 5, Five: 7, Six: 11, Seven: 13, Eight: 17,
+	Nine: 19, Ten: 23, Jack: 29, Queen: 31, King: 37, Ace: 41,
+}
+
+// handRankInfo holds the category and tiebreakers for one HandRank so that a
+// full Hand can be rebuilt from the numeric value.
+type handRankInfo struct {
+	category    HandCategory
+	tiebreakers [5]Rank
+	n           uint8 // Number of valid entries in tiebreakers
+}
+
+// Lookup tables, built once at package initialization.
+var (
+	flushRanks     [1 << 13]HandRank // 5-bit rank mask -> rank when all cards share a suit
+	uniqueRanks    [1 << 13]HandRank // 5-bit rank mask -> rank for distinct, unsuited ranks
+	pairedProducts []uint32          // Sorted prime products of hands with a repeated rank
+	pairedRanks    []HandRank        // Rank for each entry in pairedProducts
+	rankInfo       [MaxHandRank + 1]handRankInfo
+)
+
+func init() {
+	buildHandRankTables()
+}
+
+// rankBit returns the bit for a rank in a 13-bit rank mask (Two is bit 0).
+func rankBit(r Rank) uint16 {
+	return 1 << uint(r-Two)
+}
+
+// handKey packs a category and its tiebreakers into an integer whose natural
+// ordering matches CompareHands for hands of the same tiebreaker length.
+func handKey(h *Hand) uint32 {
+	key := uint32(h.Category)
+	for i := 0; i < 5; i++ {
+		key <<= 4
+		if i < len(h.Tiebreakers) {
+			key |= uint32(h.Tiebreakers[i])
+		}
+	}
+	return key
+}
+
+// buildHandRankTables enumerates one representative hand for every distinct
+// rank multiset (plus a suited variant for five distinct ranks), evaluates it
+// with EvaluateHand, and numbers the resulting hand values from weakest to
+// strongest. Deriving the tables from EvaluateHand guarantees that HandRank
+// ordering agrees exactly with CompareHands.
+func buildHandRankTables() {
+	type entry struct {
+		key     uint32
+		hand    *Hand
+		flush   bool
+		mask    uint16
+		product uint32
+	}
+
+	var entries []entry
+	var counts [15]int
+
+	// Walk every multiset of 5 ranks with no rank used more than 4 times
+	var walk func(r Rank, remaining int)
+	walk = func(r Rank, remaining int) {
+		if remaining == 0 {
+			cards := make([]Card, 0, 5)
+			var mask uint16
+			product := uint32(1)
+			for rank := Two; rank <= Ace; rank++ {
+				for i := 0; i < counts[rank]; i++ {
+					// The i-th card of a rank gets suit i, so paired hands never form a flush
+					cards = append(cards, Card{Rank: rank, Suit: Suit(i)})
+					product *= rankPrimes[rank]
+				}
+				if counts[rank] > 0 {
+					mask |= rankBit(rank)
+				}
+			}
+
+			if countBits(mask) == 5 {
+				// Distinct ranks: break the accidental flush and add the suited variant
+				cards[4].Suit = Diamonds
+				unsuited := EvaluateHand(cards)
+				entries = append(entries, entry{key: handKey(unsuited), hand: unsuited, mask: mask})
+
+				suited := make([]Card, 5)
+				for i, c := range cards {
+					suited[i] = Card{Rank: c.Rank, Suit: Spades}
+				}
+				flush := EvaluateHand(suited)
+				entries = append(entries, entry{key: handKey(flush), hand: flush, flush: true, mask: mask})
+				return
+			}
+
+			hand := EvaluateHand(cards)
+			entries = append(entries, entry{key: handKey(hand), hand: hand, product: product})
+			return
+		}
+		if r > Ace {
+			return
+		}
+		for n := 0; n <= 4 && n <= remaining; n++ {
+			counts[r] = n
+			walk(r+1, remaining-n)
+		}
+		counts[r] = 0
+	}
+	walk(Two, 5)
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	type paired struct {
+		product uint32
+		rank    HandRank
+	}
+	var pairs []paired
+
+	var rank HandRank
+	var lastKey uint32
+	for i, e := range entries {
+		if i == 0 || e.key != lastKey {
+			rank++
+			lastKey = e.key
+			info := handRankInfo{category: e.hand.Category, n: uint8(len(e.hand.Tiebreakers))}
+			copy(info.tiebreakers[:], e.hand.Tiebreakers)
+			rankInfo[rank] = info
+		}
+
+		switch {
+		case e.flush:
+			flushRanks[e.mask] = rank
+		case e.product == 0:
+			uniqueRanks[e.mask] = rank
+		default:
+			pairs = append(pairs, paired{product: e.product, rank: rank})
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].product < pairs[j].product })
+	pairedProducts = make([]uint32, len(pairs))
+	pairedRanks = make([]HandRank, len(pairs))
+	for i, p := range pairs {
+		pairedProducts[i] = p.product
+		pairedRanks[i] = p.rank
+	}
+}
+
+// countBits returns the number of set bits in a 13-bit rank mask.
+func countBits(mask uint16) int {
+	n := 0
+	for mask != 0 {
+		mask &= mask - 1
+		n++
+	}
+	return n
+}
+
+// lookupPaired finds the rank for a hand with at least one repeated rank
+// by binary searching its prime product. Returns 0 if the product is unknown
+// (for example, five cards of the same rank).
+func lookupPaired(product uint32) HandRank {
+	lo, hi := 0, len(pairedProducts)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if pairedProducts[mid] < product {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(pairedProducts) && pairedProducts[lo] == product {
+		return pairedRanks[lo]
+	}
+	return 0
+}
+
+// EvaluateHandRank evaluates exactly 5 cards and returns their HandRank
+// using precomputed lookup tables. It performs no allocations.
+// Returns 0 if the input is not exactly 5 valid cards.
+func EvaluateHandRank(cards []Card) HandRank {
+	if len(cards) != 5 {
+		return 0
+	}
+
+	var mask uint16
+	product := uint32(1)
+	suits := 0
+	for _, card := range cards {
+		if card.Rank < Two || card.Rank > Ace || card.Suit < Hearts || card.Suit > Spades {
+			return 0
+		}
+		mask |= rankBit(card.Rank)
+		product *= rankPrimes[card.Rank]
+		suits |= 1 << uint(card.Suit)
+	}
+
+	// Five distinct ranks: a flush, straight or high card hand
+	if countBits(mask) == 5 {
+		if suits&(suits-1) == 0 {
+			return flushRanks[mask]
+		}
+		return uniqueRanks[mask]
+	}
+
+	return lookupPaired(product)
+}
+
+// Category returns the hand category represented by the rank.
+// Returns 0 for an invalid rank.
+func (r HandRank) Category() HandCategory {
+	if r == 0 || r > MaxHandRank {
+		return 0
+	}
+	return rankInfo[r].category
+}
+
+// Tiebreakers returns the tiebreaker ranks for the hand value, in the same
+// format EvaluateHand produces. Returns nil for an invalid rank.
+func (r HandRank) Tiebreakers() []Rank {
+	if r == 0 || r > MaxHandRank {
+		return nil
+	}
+	info := &rankInfo[r]
+	tiebreakers := make([]Rank, info.n)
+	copy(tiebreakers, info.tiebreakers[:info.n])
+	return tiebreakers
+}
+
+// Hand builds the Hand equivalent to this rank for the given cards.
+// The result has the same Category and Tiebreakers that EvaluateHand
+// returns for those cards. Returns nil for an invalid rank.
+func (r HandRank) Hand(cards []Card) *Hand {
+	if r == 0 || r > MaxHandRank {
+		return nil
+	}
+	return &Hand{
+		Cards:       cards,
+		Category:    r.Category(),
+		Tiebreakers: r.Tiebreakers(),
+	}
+}
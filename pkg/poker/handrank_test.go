@@ -0,0 +1,177 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// mustParseCards parses card notation for tests, failing immediately on error.
+func mustParseCards(t testing.TB, notations ...string) []Card {
+	t.Helper()
+	cards := make([]Card, len(notations))
+	for i, s := range notations {
+		card, err := ParseCard(s)
+		if err != nil {
+			t.Fatalf("ParseCard(%q) returned error: %v", s, err)
+		}
+		cards[i] = card
+	}
+	return cards
+}
+
+// TestHandRankTableSize verifies that exactly 7462 distinct hand values exist
+// and that every category occupies the expected number of slots.
+func TestHandRankTableSize(t *testing.T) {
+	expected := map[HandCategory]int{
+		HighCard:      1277,
+		OnePair:       2860,
+		TwoPair:       858,
+		ThreeOfAKind:  858,
+		Straight:      10,
+		Flush:         1277,
+		FullHouse:     156,
+		FourOfAKind:   156,
+		StraightFlush: 9,
+		RoyalFlush:    1,
+	}
+
+	got := make(map[HandCategory]int)
+	for r := HandRank(1); r <= MaxHandRank; r++ {
+		got[r.Category()]++
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("category counts = %v, want %v", got, expected)
+	}
+}
+
+// TestHandRankBoundaries verifies the weakest and strongest possible hands.
+func TestHandRankBoundaries(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+		want  HandRank
+	}{
+		{"seven high is weakest", []string{"7h", "5d", "4c", "3s", "2h"}, 1},
+		{"royal flush is strongest", []string{"Ah", "Kh", "Qh", "Jh", "Th"}, MaxHandRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EvaluateHandRank(mustParseCards(t, tt.cards...))
+			if got != tt.want {
+				t.Errorf("EvaluateHandRank(%v) = %d, want %d", tt.cards, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEvaluateHandRankInvalidInput verifies that malformed input returns 0.
+func TestEvaluateHandRankInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"four cards", mustParseCards(t, "Ah", "Kh", "Qh", "Jh")},
+		{"six cards", mustParseCards(t, "Ah", "Kh", "Qh", "Jh", "Th", "9h")},
+		{"invalid rank", []Card{{Rank: 1, Suit: Hearts}, {Rank: Two, Suit: Hearts}, {Rank: Three, Suit: Hearts}, {Rank: Four, Suit: Hearts}, {Rank: Five, Suit: Hearts}}},
+		{"five of one rank", []Card{{Rank: Ace, Suit: Hearts}, {Rank: Ace, Suit: Diamonds}, {Rank: Ace, Suit: Clubs}, {Rank: Ace, Suit: Spades}, {Rank: Ace, Suit: Hearts}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateHandRank(tt.cards); got != 0 {
+				t.Errorf("EvaluateHandRank() = %d, want 0", got)
+			}
+		})
+	}
+}
+
+// TestHandRankMatchesEvaluateHand exhaustively checks all 2,598,960 five-card
+// hands: the rank must rebuild the same category and tiebreakers as EvaluateHand.
+// Since ranks are assigned in CompareHands order, this proves ordering agreement.
+func TestHandRankMatchesEvaluateHand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping exhaustive hand enumeration in short mode")
+	}
+
+	deck := NewDeck().Cards
+	hand := make([]Card, 5)
+	count := 0
+
+	for a := 0; a < len(deck); a++ {
+		for b := a + 1; b < len(deck); b++ {
+			for c := b + 1; c < len(deck); c++ {
+				for d := c + 1; d < len(deck); d++ {
+					for e := d + 1; e < len(deck); e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						count++
+
+						rank := EvaluateHandRank(hand)
+						expected := EvaluateHand(hand)
+						if rank.Category() != expected.Category || !reflect.DeepEqual(rank.Tiebreakers(), expected.Tiebreakers) {
+							t.Fatalf("hand %v: rank %d gives %v %v, EvaluateHand gives %v %v",
+								hand, rank, rank.Category(), rank.Tiebreakers(), expected.Category, expected.Tiebreakers)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if count != 2598960 {
+		t.Errorf("enumerated %d hands, want 2598960", count)
+	}
+}
+
+// TestHandRankOrderingMatchesCompareHands verifies that adjacent ranks compare
+// strictly increasing with CompareHands.
+func TestHandRankOrderingMatchesCompareHands(t *testing.T) {
+	prev := HandRank(1).Hand(nil)
+	for r := HandRank(2); r <= MaxHandRank; r++ {
+		current := r.Hand(nil)
+		if CompareHands(current, prev) != 1 {
+			t.Fatalf("rank %d (%v %v) does not beat rank %d (%v %v)",
+				r, current.Category, current.Tiebreakers, r-1, prev.Category, prev.Tiebreakers)
+		}
+		prev = current
+	}
+}
+
+// TestHandRankHand verifies that a Hand derived from a rank matches EvaluateHand.
+func TestHandRankHand(t *testing.T) {
+	cards := mustParseCards(t, "Kh", "Kd", "7c", "7s", "Kc")
+	hand := EvaluateHandRank(cards).Hand(cards)
+
+	if hand.Category != FullHouse {
+		t.Errorf("Category = %v, want %v", hand.Category, FullHouse)
+	}
+	if !reflect.DeepEqual(hand.Tiebreakers, []Rank{King, Seven}) {
+		t.Errorf("Tiebreakers = %v, want [King Seven]", hand.Tiebreakers)
+	}
+	if !reflect.DeepEqual(hand.Cards, cards) {
+		t.Errorf("Cards = %v, want %v", hand.Cards, cards)
+	}
+
+	if HandRank(0).Hand(cards) != nil {
+		t.Error("HandRank(0).Hand() should return nil")
+	}
+}
+
+// TestEvaluateHandRankZeroAllocations verifies the lookup path never allocates.
+func TestEvaluateHandRankZeroAllocations(t *testing.T) {
+	hands := [][]Card{
+		mustParseCards(t, "Kh", "Kd", "Qc", "Js", "9h"),
+		mustParseCards(t, "Ah", "Th", "7h", "5h", "3h"),
+		mustParseCards(t, "9h", "8d", "7c", "6s", "5h"),
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, hand := range hands {
+			_ = EvaluateHandRank(hand)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("EvaluateHandRank allocated %.1f times per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,178 @@
+package poker
+
+import "sort"
+
+// groupSizes returns how many cards each tiebreaker rank stands for, in
+// tiebreaker order, for categories made of rank groups.
+func groupSizes(c HandCategory) []int {
+	switch c {
+	case OnePair:
+		return []int{2, 1, 1, 1}
+	case TwoPair:
+		return []int{2, 2, 1}
+	case ThreeOfAKind:
+		return []int{3, 1, 1}
+	case FullHouse:
+		return []int{3, 2}
+	case FourOfAKind:
+		return []int{4, 1}
+	case FiveOfAKind:
+		return []int{5}
+	default:
+		// High Card and Flush: one card per tiebreaker
+		return []int{1, 1, 1, 1, 1}
+	}
+}
+
+// madeGroups returns how many leading tiebreakers describe the made hand;
+// the tiebreakers after them are kickers.
+func madeGroups(c HandCategory) int {
+	switch c {
+	case HighCard, OnePair, ThreeOfAKind, FourOfAKind:
+		return 1
+	case TwoPair:
+		return 2
+	default:
+		return 5
+	}
+}
+
+// displaySlots returns the rank of each card position in display order.
+func (h *Hand) displaySlots() []Rank {
+	switch h.Category {
+	case Straight, StraightFlush, RoyalFlush:
+		high := Ace
+		if len(h.Tiebreakers) > 0 {
+			high = h.Tiebreakers[0]
+		}
+		slots := []Rank{high, high - 1, high - 2, high - 3, high - 4}
+		if high == h.Rules.lowStraightHigh() {
+			slots[4] = Ace
+		}
+		return slots
+	}
+
+	var slots []Rank
+	for i, size := range groupSizes(h.Category) {
+		if i == len(h.Tiebreakers) {
+			break
+		}
+		for range size {
+			slots = append(slots, h.Tiebreakers[i])
+		}
+	}
+	return slots
+}
+
+// Ordered returns the hand's cards in canonical display order: the made
+// hand first, largest group first, then kickers from highest to lowest,
+// for example K-K-K-7-7 or 9-9-A-8-4. Straights run from the high card
+// down, with the ace last in a wheel (5-4-3-2-A). Cards of equal rank are
+// ordered by suit. Wild cards take the place of the card they stand for.
+// Hand.Cards itself is left in its original order.
+func (h *Hand) Ordered() []Card {
+	cards := make([]Card, len(h.Cards))
+	copy(cards, h.Cards)
+	sort.SliceStable(cards, func(i, j int) bool { return cards[i].Suit < cards[j].Suit })
+
+	// assigned[s] is the index of the card shown in slot s, or -1
+	slots := h.displaySlots()
+	assigned := make([]int, len(slots))
+	used := make([]bool, len(cards))
+
+	// Natural cards fill the slots for their rank
+	for s, rank := range slots {
+		assigned[s] = -1
+		for i, card := range cards {
+			if !used[i] && card.Rank == rank {
+				assigned[s], used[i] = i, true
+				break
+			}
+		}
+	}
+
+	// Remaining cards, such as wild cards, fill the empty slots in order
+	next := 0
+	for s := range slots {
+		for next < len(cards) && used[next] {
+			next++
+		}
+		if assigned[s] < 0 && next < len(cards) {
+			assigned[s], used[next] = next, true
+		}
+	}
+
+	ordered := make([]Card, 0, len(cards))
+	for _, i := range assigned {
+		if i >= 0 {
+			ordered = append(ordered, cards[i])
+		}
+	}
+	// Anything that matched no slot goes last
+	for i, card := range cards {
+		if !used[i] {
+			ordered = append(ordered, card)
+		}
+	}
+	return ordered
+}
+
+// madeCount returns how many cards of a full hand make its category; the
+// rest are kickers.
+func madeCount(c HandCategory) int {
+	switch c {
+	case HighCard:
+		return 1
+	case OnePair:
+		return 2
+	case ThreeOfAKind:
+		return 3
+	case TwoPair, FourOfAKind:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// Made returns the cards that make the hand's category, in display order:
+// the pair of a one-pair hand, both pairs of two pair, or all five cards of
+// a straight, flush or full house. A high-card hand is made by its top card.
+func (h *Hand) Made() []Card {
+	ordered := h.Ordered()
+	return ordered[:min(madeCount(h.Category), len(ordered))]
+}
+
+// Kickers returns the cards that only break ties between hands of the same
+// made hand, from highest to lowest. Straights, flushes and full houses
+// have no kickers.
+func (h *Hand) Kickers() []Card {
+	ordered := h.Ordered()
+	return ordered[min(madeCount(h.Category), len(ordered)):]
+}
+
+// DecidingKickers reports which kickers decided a CompareHands result
+// between two hands of the same category. It returns each hand's kickers
+// from the highest down to and including the first one that differs, for
+// example the Ace and the King behind a pair of Queens when A-K beats A-J.
+// Returns nil, nil if either hand is nil, the categories differ, the made
+// hands differ, or the hands tie.
+func DecidingKickers(hand1, hand2 *Hand) (kickers1, kickers2 []Card) {
+	if hand1 == nil || hand2 == nil || hand1.Category != hand2.Category {
+		return nil, nil
+	}
+
+	made := madeGroups(hand1.Category)
+	n := min(len(hand1.Tiebreakers), len(hand2.Tiebreakers))
+	for i := 0; i < n; i++ {
+		if hand1.Tiebreakers[i] == hand2.Tiebreakers[i] {
+			continue
+		}
+		if i < made {
+			return nil, nil
+		}
+		k1, k2 := hand1.Kickers(), hand2.Kickers()
+		d := i - made + 1
+		return k1[:min(d, len(k1))], k2[:min(d, len(k2))]
+	}
+	return nil, nil
+}
@@ -0,0 +1,90 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestHandOrdered verifies canonical display order and the made/kicker split.
+func TestHandOrdered(t *testing.T) {
+	tests := []struct {
+		name    string
+		hand    *Hand
+		ordered []string
+		made    int
+	}{
+		{"high card", EvaluateHand(mustParseCards(t, "4h", "Kd", "9c", "Ah", "6s")), []string{"Ah", "Kd", "9c", "6s", "4h"}, 1},
+		{"one pair", EvaluateHand(mustParseCards(t, "Ac", "9s", "4h", "9h", "8d")), []string{"9h", "9s", "Ac", "8d", "4h"}, 2},
+		{"two pair", EvaluateHand(mustParseCards(t, "Kh", "9c", "Ad", "9s", "Ah")), []string{"Ah", "Ad", "9c", "9s", "Kh"}, 4},
+		{"three of a kind", EvaluateHand(mustParseCards(t, "2h", "7d", "As", "7c", "7h")), []string{"7h", "7d", "7c", "As", "2h"}, 3},
+		{"wheel", EvaluateHand(mustParseCards(t, "3c", "Ah", "5h", "2d", "4s")), []string{"5h", "4s", "3c", "2d", "Ah"}, 5},
+		{"straight", EvaluateHand(mustParseCards(t, "Jc", "9h", "Kh", "Qs", "Td")), []string{"Kh", "Qs", "Jc", "Td", "9h"}, 5},
+		{"full house", EvaluateHand(mustParseCards(t, "7s", "Kh", "7h", "Kd", "Kc")), []string{"Kh", "Kd", "Kc", "7h", "7s"}, 5},
+		{"four of a kind", EvaluateHand(mustParseCards(t, "Kh", "9d", "9c", "9s", "9h")), []string{"9h", "9d", "9c", "9s", "Kh"}, 4},
+		{"royal flush", EvaluateHand(mustParseCards(t, "Th", "Jh", "Qh", "Kh", "Ah")), []string{"Ah", "Kh", "Qh", "Jh", "Th"}, 5},
+		{"short deck wheel", EvaluateHandWithRules(mustParseCards(t, "6h", "Ac", "8d", "9s", "7h"), ShortDeckRules), []string{"9s", "8d", "7h", "6h", "Ac"}, 5},
+		{"partial pair", EvaluatePartialHand(mustParseCards(t, "Ac", "Td", "Th")), []string{"Th", "Td", "Ac"}, 2},
+		{"joker fills quads", EvaluateWildHand(mustParseCards(t, "Jr", "Kh", "7c", "Kd", "Ks"), WildRules{}), []string{"Kh", "Kd", "Ks", "Jr", "7c"}, 4},
+		{"joker fills a straight", EvaluateWildHand(mustParseCards(t, "9c", "Jr", "Qh", "Ks", "Td"), WildRules{}), []string{"Ks", "Qh", "Jr", "Td", "9c"}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := mustParseCards(t, tt.ordered...)
+			if got := tt.hand.Ordered(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Ordered() = %v, want %v", got, want)
+			}
+			if got := tt.hand.Made(); !reflect.DeepEqual(got, want[:tt.made]) {
+				t.Errorf("Made() = %v, want %v", got, want[:tt.made])
+			}
+			if got := tt.hand.Kickers(); !reflect.DeepEqual(got, want[tt.made:]) {
+				t.Errorf("Kickers() = %v, want %v", got, want[tt.made:])
+			}
+		})
+	}
+}
+
+// TestHandOrderedKeepsCards verifies that Ordered does not reorder Hand.Cards.
+func TestHandOrderedKeepsCards(t *testing.T) {
+	cards := mustParseCards(t, "4h", "Kd", "9c", "Ah", "6s")
+	hand := EvaluateHand(cards)
+	hand.Ordered()
+	if !reflect.DeepEqual(hand.Cards, cards) {
+		t.Errorf("Cards = %v, want %v", hand.Cards, cards)
+	}
+}
+
+// TestDecidingKickers verifies which kickers are reported for a comparison.
+func TestDecidingKickers(t *testing.T) {
+	hand := func(cards ...string) *Hand { return EvaluateHand(mustParseCards(t, cards...)) }
+
+	tests := []struct {
+		name     string
+		hand1    *Hand
+		hand2    *Hand
+		kickers1 []string
+		kickers2 []string
+	}{
+		{"first kicker decides", hand("Qh", "Qd", "Ac", "7s", "3h"), hand("Qc", "Qs", "Kd", "8h", "4c"), []string{"Ac"}, []string{"Kd"}},
+		{"second kicker decides", hand("Qh", "Qd", "Ac", "Ks", "3h"), hand("Qc", "Qs", "Ad", "Jh", "4c"), []string{"Ac", "Ks"}, []string{"Ad", "Jh"}},
+		{"two pair kicker", hand("Ah", "Ad", "9c", "9s", "Kh"), hand("As", "Ac", "9h", "9d", "Qh"), []string{"Kh"}, []string{"Qh"}},
+		{"high card", hand("Ah", "Kd", "9c", "6s", "4h"), hand("Ad", "Kc", "9s", "5h", "2d"), []string{"Kd", "9c", "6s"}, []string{"Kc", "9s", "5h"}},
+		{"pair decides", hand("Kh", "Kd", "2c", "3s", "4h"), hand("Qh", "Qd", "Ac", "Ks", "Jh"), nil, nil},
+		{"category decides", hand("Kh", "Kd", "Kc", "3s", "4h"), hand("Qh", "Qd", "Ac", "Ks", "Jh"), nil, nil},
+		{"tie", hand("Kh", "Kd", "Ac", "3s", "4h"), hand("Ks", "Kc", "Ad", "3h", "4c"), nil, nil},
+		{"nil hand", nil, hand("Ks", "Kc", "Ad", "3h", "4c"), nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want1, want2 []Card
+			if tt.kickers1 != nil {
+				want1, want2 = mustParseCards(t, tt.kickers1...), mustParseCards(t, tt.kickers2...)
+			}
+			got1, got2 := DecidingKickers(tt.hand1, tt.hand2)
+			if !reflect.DeepEqual(got1, want1) || !reflect.DeepEqual(got2, want2) {
+				t.Errorf("DecidingKickers = %v, %v, want %v, %v", got1, got2, want1, want2)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LowQualifier is the highest card allowed in a qualifying low for
+// 8-or-better Hi-Lo games such as Omaha Hi-Lo and Stud Hi-Lo.
+const LowQualifier = Eight
+
+// LowHand is an ace-to-five low hand, where aces count as one and straights
+// and flushes are ignored. Lower hands are better. Category is HighCard for
+// an unpaired low; lowball variants that allow pairs use the paired
+// categories, which are always worse than any unpaired hand.
+type LowHand struct {
+	Cards       []Card       // The 5 cards, most significant first
+	Category    HandCategory // HighCard, or a paired category
+	Tiebreakers []Rank       // Ranks in order of importance, aces counted low
+}
+
+// lowValue returns the ace-to-five value of a rank: aces are 1, other
+// ranks keep their face value.
+func lowValue(r Rank) int {
+	if r == Ace {
+		return 1
+	}
+	return int(r)
+}
+
+// String returns the ranks of the hand from most to least significant,
+// for example "8-6-4-2-A".
+func (h *LowHand) String() string {
+	if h == nil {
+		return "no low"
+	}
+	parts := make([]string, len(h.Cards))
+	for i, card := range h.Cards {
+		parts[i] = card.Rank.String()
+	}
+	return strings.Join(parts, "-")
+}
+
+// CompareLowHands compares two low hands and returns:
+// 1 if low1 is better (lower), -1 if low2 is better, 0 if equal.
+// A nil hand means no qualifying low and loses to any low.
+func CompareLowHands(low1, low2 *LowHand) int {
+	switch {
+	case low1 == nil && low2 == nil:
+		return 0
+	case low2 == nil:
+		return 1
+	case low1 == nil:
+		return -1
+	}
+
+	// Fewer and smaller sets of paired cards are better
+	if low1.Category < low2.Category {
+		return 1
+	}
+	if low1.Category > low2.Category {
+		return -1
+	}
+
+	for i := 0; i < len(low1.Tiebreakers) && i < len(low2.Tiebreakers); i++ {
+		v1, v2 := lowValue(low1.Tiebreakers[i]), lowValue(low2.Tiebreakers[i])
+		if v1 < v2 {
+			return 1
+		}
+		if v1 > v2 {
+			return -1
+		}
+	}
+	return 0
+}
+
+// unpairedLow builds the best unpaired low from cards whose low value is at
+// most qualifier, or returns nil if fewer than five distinct ranks qualify.
+// For each rank the first matching card in input order is used.
+func unpairedLow(cards []Card, qualifier Rank) *LowHand {
+	var byValue [Ace + 1]*Card
+	for i := range cards {
+		card := &cards[i]
+		v := lowValue(card.Rank)
+		if card.Rank < Two || card.Rank > Ace || v > lowValue(qualifier) {
+			continue
+		}
+		if byValue[v] == nil {
+			byValue[v] = card
+		}
+	}
+
+	// The five smallest distinct values make the lowest possible hand
+	low := &LowHand{Category: HighCard}
+	for v := 1; v <= int(Ace) && len(low.Cards) < 5; v++ {
+		if byValue[v] != nil {
+			low.Cards = append(low.Cards, *byValue[v])
+		}
+	}
+	if len(low.Cards) < 5 {
+		return nil
+	}
+
+	// Most significant (highest) card first
+	for i, j := 0, len(low.Cards)-1; i < j; i, j = i+1, j-1 {
+		low.Cards[i], low.Cards[j] = low.Cards[j], low.Cards[i]
+	}
+	low.Tiebreakers = make([]Rank, 5)
+	for i, card := range low.Cards {
+		low.Tiebreakers[i] = card.Rank
+	}
+	return low
+}
+
+// FindBestLowHand finds the best 8-or-better ace-to-five low from 5 or more
+// cards: five unpaired cards of rank 8 or lower, aces low.
+// Returns nil if there is no qualifying low or any card is invalid or repeated.
+func FindBestLowHand(cards []Card) *LowHand {
+	if len(cards) < 5 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+	return unpairedLow(cards, LowQualifier)
+}
+
+// FindBestOmahaLowHand finds the best 8-or-better low in Omaha Hi-Lo, using
+// exactly 2 hole cards and exactly 3 board cards. The card limits match
+// FindBestOmahaHand. Returns nil if there is no qualifying low or the cards
+// are not a legal Omaha showdown.
+func FindBestOmahaLowHand(hole, board []Card) *LowHand {
+	if !validOmahaCards(hole, board) {
+		return nil
+	}
+
+	var best *LowHand
+	omahaHands(hole, board, func(five []Card) {
+		if low := unpairedLow(five, LowQualifier); low != nil && CompareLowHands(low, best) > 0 {
+			best = low
+		}
+	})
+	return best
+}
+
+// HiLoResult describes how a Hi-Lo pot is split.
+type HiLoResult struct {
+	HighWinners []int // Indexes of players sharing the high half
+	LowWinners  []int // Indexes of players sharing the low half; empty if no low qualified
+	Payouts     []int // Chips won by each player
+}
+
+// bestIndexes returns the indexes of the best entries according to cmp,
+// skipping those for which skip returns true.
+func bestIndexes(n int, skip func(i int) bool, cmp func(i, j int) int) []int {
+	var best []int
+	for i := 0; i < n; i++ {
+		if skip(i) {
+			continue
+		}
+		switch {
+		case len(best) == 0:
+			best = []int{i}
+		case cmp(i, best[0]) > 0:
+			best = []int{i}
+		case cmp(i, best[0]) == 0:
+			best = append(best, i)
+		}
+	}
+	return best
+}
+
+// splitChips divides amount among winners, giving remainder chips one at a
+// time to the winners in the order given.
+func splitChips(amount int, winners []int, payouts []int) {
+	if len(winners) == 0 {
+		return
+	}
+	share, odd := amount/len(winners), amount%len(winners)
+	for k, i := range winners {
+		payouts[i] += share
+		if k < odd {
+			payouts[i]++
+		}
+	}
+}
+
+// SplitHiLoPot splits a pot between the best high hand and the best
+// qualifying low. highs and lows hold each player's hands in seat order
+// starting left of the button; a nil high hand marks a player who is not
+// in the showdown, and a nil low hand means no qualifying low.
+//
+// If no player has a qualifying low, the high hand scoops the whole pot.
+// Otherwise each half is shared among its tied winners. An odd chip from
+// halving the pot goes to the high half, and odd chips within a half go to
+// the earliest winners in seat order.
+func SplitHiLoPot(pot int, highs []*Hand, lows []*LowHand) (*HiLoResult, error) {
+	if pot < 0 {
+		return nil, fmt.Errorf("pot must not be negative, got %d", pot)
+	}
+	if len(highs) != len(lows) {
+		return nil, fmt.Errorf("got %d high hands but %d low hands", len(highs), len(lows))
+	}
+
+	folded := func(i int) bool { return highs[i] == nil }
+	res := &HiLoResult{
+		HighWinners: bestIndexes(len(highs), folded, func(i, j int) int { return CompareHands(highs[i], highs[j]) }),
+		LowWinners: bestIndexes(len(lows), func(i int) bool { return folded(i) || lows[i] == nil },
+			func(i, j int) int { return CompareLowHands(lows[i], lows[j]) }),
+		Payouts: make([]int, len(highs)),
+	}
+	if len(res.HighWinners) == 0 {
+		return nil, fmt.Errorf("no player has a high hand")
+	}
+
+	if len(res.LowWinners) == 0 {
+		splitChips(pot, res.HighWinners, res.Payouts)
+		return res, nil
+	}
+	lowHalf := pot / 2
+	splitChips(pot-lowHalf, res.HighWinners, res.Payouts)
+	splitChips(lowHalf, res.LowWinners, res.Payouts)
+	return res, nil
+}
@@ -0,0 +1,181 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFindBestLowHand verifies 8-or-better low selection from 5 to 7 cards.
+func TestFindBestLowHand(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+		want  string
+	}{
+		{"wheel is the best low despite the straight", []string{"Ah", "2d", "3c", "4s", "5h"}, "5-4-3-2-A"},
+		{"flush does not spoil the low", []string{"Ah", "2h", "4h", "6h", "7h"}, "7-6-4-2-A"},
+		{"eight low", []string{"8c", "6d", "4h", "2s", "Ac"}, "8-6-4-2-A"},
+		{"lowest five of seven", []string{"Kh", "8c", "7d", "3h", "2s", "6c", "4d"}, "7-6-4-3-2"},
+		{"pairs are skipped", []string{"2h", "2d", "3c", "3s", "5h", "7d", "8c"}, "8-7-5-3-2"},
+		{"nine does not qualify", []string{"9h", "7d", "5c", "3s", "2h"}, "no low"},
+		{"only four distinct low ranks", []string{"Ah", "Ad", "2c", "3s", "4h", "Kd", "Qc"}, "no low"},
+		{"too few cards", []string{"Ah", "2d", "3c", "4s"}, "no low"},
+		{"repeated card", []string{"Ah", "Ah", "2d", "3c", "4s", "5d"}, "no low"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low := FindBestLowHand(mustParseCards(t, tt.cards...))
+			if got := low.String(); got != tt.want {
+				t.Errorf("FindBestLowHand = %s, want %s", got, tt.want)
+			}
+			if low != nil && (low.Category != HighCard || len(low.Tiebreakers) != 5) {
+				t.Errorf("low = %+v, want a HighCard category with 5 tiebreakers", low)
+			}
+		})
+	}
+}
+
+// TestCompareLowHands verifies ace-to-five ordering, including no low.
+func TestCompareLowHands(t *testing.T) {
+	low := func(cards ...string) *LowHand {
+		h := FindBestLowHand(mustParseCards(t, cards...))
+		if h == nil {
+			t.Fatalf("FindBestLowHand(%v) = nil", cards)
+		}
+		return h
+	}
+
+	wheel := low("Ah", "2d", "3c", "4s", "5h")
+	sixFour := low("6h", "4d", "3c", "2s", "Ah")
+	sixFive := low("6c", "5d", "3h", "2h", "Ad")
+	eightSeven := low("8h", "7d", "3c", "2s", "Ah")
+	eightSix := low("8s", "6s", "5s", "4s", "3s")
+	otherWheel := low("As", "2s", "3h", "4h", "5c")
+
+	tests := []struct {
+		name     string
+		low1     *LowHand
+		low2     *LowHand
+		expected int
+	}{
+		{"wheel beats six low", wheel, sixFour, 1},
+		{"six-four beats six-five", sixFour, sixFive, 1},
+		{"eight-six beats eight-seven", eightSix, eightSeven, 1},
+		{"eight-seven loses to six-five", eightSeven, sixFive, -1},
+		{"wheels tie regardless of suits", wheel, otherWheel, 0},
+		{"any low beats no low", eightSeven, nil, 1},
+		{"no low loses", nil, wheel, -1},
+		{"no low ties no low", nil, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareLowHands(tt.low1, tt.low2); got != tt.expected {
+				t.Errorf("CompareLowHands(%v, %v) = %d, want %d", tt.low1, tt.low2, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFindBestOmahaLowHand verifies that Omaha lows use exactly 2 hole
+// cards and 3 board cards.
+func TestFindBestOmahaLowHand(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []string
+		board []string
+		want  string
+	}{
+		{"nut low", []string{"Ah", "2d", "Kc", "Ks"}, []string{"3h", "4s", "8d", "Qc", "Jh"}, "8-4-3-2-A"},
+		{"one low hole card is not enough", []string{"Ah", "Kd", "Qc", "Js"}, []string{"2h", "3s", "4d", "5c", "Th"}, "no low"},
+		{"five low board cards need two more low hole cards", []string{"Ah", "Ad", "Kc", "Ks"}, []string{"2h", "3s", "4d", "5c", "6h"}, "no low"},
+		{"ace on the board does not counterfeit the hole ace", []string{"Ah", "3d", "Kc", "Ks"}, []string{"Ac", "2s", "5d", "7c", "Jh"}, "7-5-3-2-A"},
+		{"only two low board cards", []string{"Ah", "2d", "3c", "4s"}, []string{"5h", "6s", "Kd", "Qc", "Jh"}, "no low"},
+		{"best two of four hole cards", []string{"Ah", "2d", "3c", "8s"}, []string{"4h", "5s", "7d", "Kc", "Kh"}, "7-5-4-2-A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole := mustParseCards(t, tt.hole...)
+			low := FindBestOmahaLowHand(hole, mustParseCards(t, tt.board...))
+			if got := low.String(); got != tt.want {
+				t.Fatalf("FindBestOmahaLowHand = %s, want %s", got, tt.want)
+			}
+			if low == nil {
+				return
+			}
+			fromHole := 0
+			for _, card := range low.Cards {
+				if containsCard(hole, card) {
+					fromHole++
+				}
+			}
+			if fromHole != 2 {
+				t.Errorf("low %v uses %d hole cards, want 2", low.Cards, fromHole)
+			}
+		})
+	}
+
+	if low := FindBestOmahaLowHand(mustParseCards(t, "Ah", "2d", "3c"), mustParseCards(t, "4h", "5s", "7d")); low != nil {
+		t.Errorf("three hole cards returned %v, want nil", low)
+	}
+}
+
+// TestSplitHiLoPot verifies scoops, split halves, quartering and odd chips.
+func TestSplitHiLoPot(t *testing.T) {
+	high := func(cards ...string) *Hand { return FindBestHand(mustParseCards(t, cards...)) }
+	low := func(cards ...string) *LowHand { return FindBestLowHand(mustParseCards(t, cards...)) }
+
+	flush := high("Ah", "Kh", "9h", "4h", "2h")
+	straight := high("9c", "8d", "7s", "6h", "5c")
+	pair := high("Ks", "Kd", "7c", "4d", "2c")
+	wheel := low("Ac", "2d", "3s", "4d", "5s")
+	eightLow := low("8c", "6d", "4s", "3d", "2s")
+
+	tests := []struct {
+		name     string
+		pot      int
+		highs    []*Hand
+		lows     []*LowHand
+		payouts  []int
+		highWins []int
+		lowWins  []int
+	}{
+		{"high scoops without a qualifying low", 100, []*Hand{flush, pair}, []*LowHand{nil, nil}, []int{100, 0}, []int{0}, nil},
+		{"high and low split", 100, []*Hand{flush, pair}, []*LowHand{nil, wheel}, []int{50, 50}, []int{0}, []int{1}},
+		{"one player scoops both halves", 100, []*Hand{straight, pair}, []*LowHand{wheel, eightLow}, []int{100, 0}, []int{0}, []int{0}},
+		{"quartered low", 100, []*Hand{flush, pair, straight}, []*LowHand{nil, wheel, wheel}, []int{50, 25, 25}, []int{0}, []int{1, 2}},
+		{"odd chip goes to the high half", 101, []*Hand{flush, pair}, []*LowHand{nil, wheel}, []int{51, 50}, []int{0}, []int{1}},
+		{"odd chip within a half goes to the earliest seat", 103, []*Hand{flush, pair, straight}, []*LowHand{nil, wheel, wheel}, []int{52, 26, 25}, []int{0}, []int{1, 2}},
+		{"folded player is ignored", 60, []*Hand{nil, pair, flush}, []*LowHand{wheel, nil, nil}, []int{0, 0, 60}, []int{2}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := SplitHiLoPot(tt.pot, tt.highs, tt.lows)
+			if err != nil {
+				t.Fatalf("SplitHiLoPot returned error: %v", err)
+			}
+			if !reflect.DeepEqual(res.Payouts, tt.payouts) {
+				t.Errorf("Payouts = %v, want %v", res.Payouts, tt.payouts)
+			}
+			if !reflect.DeepEqual(res.HighWinners, tt.highWins) {
+				t.Errorf("HighWinners = %v, want %v", res.HighWinners, tt.highWins)
+			}
+			if !reflect.DeepEqual(res.LowWinners, tt.lowWins) {
+				t.Errorf("LowWinners = %v, want %v", res.LowWinners, tt.lowWins)
+			}
+		})
+	}
+
+	if _, err := SplitHiLoPot(100, []*Hand{flush}, nil); err == nil {
+		t.Error("mismatched hand counts should return an error")
+	}
+	if _, err := SplitHiLoPot(-1, []*Hand{flush}, []*LowHand{nil}); err == nil {
+		t.Error("a negative pot should return an error")
+	}
+	if _, err := SplitHiLoPot(100, []*Hand{nil, nil}, []*LowHand{wheel, nil}); err == nil {
+		t.Error("a showdown without high hands should return an error")
+	}
+}
@@ -0,0 +1,76 @@
+package poker
+
+// isWheel reports whether a hand was detected as the A-2-3-4-5 straight or
+// straight flush, which is not a straight in deuce-to-seven lowball or
+// Short Deck.
+func isWheel(hand *Hand) bool {
+	return (hand.Category == Straight || hand.Category == StraightFlush) && hand.Tiebreakers[0] == Five
+}
+
+// playWheelAceHigh reclassifies a wheel as an ace-high hand, or as an
+// ace-high flush if it is suited.
+func playWheelAceHigh(hand *Hand, cards []Card) {
+	_, hand.Tiebreakers = detectHighCard(cards)
+	if hand.Category == StraightFlush {
+		hand.Category = Flush
+	} else {
+		hand.Category = HighCard
+	}
+}
+
+// EvaluateLowball27 evaluates exactly 5 cards for deuce-to-seven lowball
+// (2-7 Single Draw and Triple Draw). Categories and tiebreakers follow
+// EvaluateHand, except that aces are always high: A-2-3-4-5 is an ace-high
+// hand rather than a straight, or an ace-high flush if suited. The best
+// possible hand is 7-5-4-3-2 unsuited.
+// Returns nil if the input is not exactly 5 valid, distinct cards.
+func EvaluateLowball27(cards []Card) *Hand {
+	if len(cards) != 5 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+
+	hand := EvaluateHand(cards)
+	if isWheel(hand) {
+		playWheelAceHigh(hand, cards)
+	}
+	return hand
+}
+
+// CompareLowball27 compares two deuce-to-seven hands and returns:
+// 1 if hand1 is better (a worse high hand), -1 if hand2 is better, 0 if equal.
+// A nil hand loses to any hand.
+func CompareLowball27(hand1, hand2 *Hand) int {
+	switch {
+	case hand1 == nil && hand2 == nil:
+		return 0
+	case hand2 == nil:
+		return 1
+	case hand1 == nil:
+		return -1
+	}
+	return -CompareHands(hand1, hand2)
+}
+
+// FindBestLowball27 finds the best deuce-to-seven hand from 5 or more cards,
+// such as a draw simulation's final cards plus discards.
+// Returns nil if fewer than 5 cards are provided or any card is invalid or
+// repeated.
+func FindBestLowball27(cards []Card) *Hand {
+	if len(cards) < 5 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+
+	var best *Hand
+	for _, combo := range Combinations(cards, 5) {
+		if hand := EvaluateLowball27(combo); CompareLowball27(hand, best) > 0 {
+			best = hand
+		}
+	}
+	return best
+}
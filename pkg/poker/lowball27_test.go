@@ -0,0 +1,112 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEvaluateLowball27 verifies deuce-to-seven categories, in particular
+// that A-2-3-4-5 is not a straight.
+func TestEvaluateLowball27(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"number one", []string{"7h", "5d", "4c", "3s", "2h"}, HighCard, []Rank{Seven, Five, Four, Three, Two}},
+		{"wheel is ace high", []string{"Ah", "2d", "3c", "4s", "5h"}, HighCard, []Rank{Ace, Five, Four, Three, Two}},
+		{"suited wheel is an ace-high flush", []string{"Ah", "2h", "3h", "4h", "5h"}, Flush, []Rank{Ace, Five, Four, Three, Two}},
+		{"six-high straight counts", []string{"6h", "5d", "4c", "3s", "2h"}, Straight, []Rank{Six}},
+		{"flush counts", []string{"8h", "6h", "4h", "3h", "2h"}, Flush, []Rank{Eight, Six, Four, Three, Two}},
+		{"pair", []string{"2h", "2d", "7c", "5s", "3h"}, OnePair, []Rank{Two, Seven, Five, Three}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := EvaluateLowball27(mustParseCards(t, tt.cards...))
+			if hand == nil {
+				t.Fatal("EvaluateLowball27 returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if !reflect.DeepEqual(hand.Tiebreakers, tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreakers)
+			}
+		})
+	}
+
+	if hand := EvaluateLowball27(mustParseCards(t, "7h", "5d", "4c", "3s")); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+	if hand := EvaluateLowball27(mustParseCards(t, "7h", "7h", "4c", "3s", "2d")); hand != nil {
+		t.Errorf("repeated card returned %v, want nil", hand)
+	}
+}
+
+// TestCompareLowball27 verifies that the order is inverted relative to
+// high hands.
+func TestCompareLowball27(t *testing.T) {
+	eval := func(cards ...string) *Hand { return EvaluateLowball27(mustParseCards(t, cards...)) }
+
+	tests := []struct {
+		name     string
+		hand1    *Hand
+		hand2    *Hand
+		expected int
+	}{
+		{"7-5 beats 7-6", eval("7h", "5d", "4c", "3s", "2h"), eval("7d", "6c", "4h", "3d", "2s"), 1},
+		{"8-high beats 9-high", eval("8h", "6d", "4c", "3s", "2h"), eval("9d", "5c", "4h", "3d", "2s"), 1},
+		{"king-high beats wheel", eval("Kh", "5d", "4c", "3s", "2h"), eval("Ah", "2d", "3c", "4s", "5h"), 1},
+		{"ace-high beats a pair", eval("Ah", "Qd", "9c", "5s", "3h"), eval("2h", "2d", "7c", "5s", "3h"), 1},
+		{"pair of deuces beats pair of threes", eval("2h", "2d", "8c", "5s", "4h"), eval("3h", "3d", "7c", "5d", "2s"), 1},
+		{"straight loses to ace high", eval("6h", "5d", "4c", "3s", "2h"), eval("Ah", "Kd", "Qc", "Js", "9h"), -1},
+		{"flush loses to a straight", eval("8h", "6h", "4h", "3h", "2h"), eval("6h", "5d", "4c", "3s", "2h"), -1},
+		{"same ranks tie", eval("7h", "5d", "4c", "3s", "2h"), eval("7c", "5s", "4h", "3d", "2d"), 0},
+		{"any hand beats nil", eval("Kh", "Kd", "Kc", "Ks", "Ah"), nil, 1},
+		{"nil loses", nil, eval("Kh", "Kd", "Kc", "Ks", "Ah"), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareLowball27(tt.hand1, tt.hand2); got != tt.expected {
+				t.Errorf("CompareLowball27 = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFindBestLowball27 verifies best-hand selection from more than 5 cards.
+func TestFindBestLowball27(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"avoids the straight", []string{"7h", "6d", "5c", "4s", "3h", "2d"}, HighCard, []Rank{Seven, Five, Four, Three, Two}},
+		{"avoids the flush", []string{"8h", "6h", "4h", "3h", "2h", "9c"}, HighCard, []Rank{Nine, Six, Four, Three, Two}},
+		{"ace plays high", []string{"Ah", "2d", "3c", "4s", "5h", "Kd", "Qc"}, HighCard, []Rank{Queen, Five, Four, Three, Two}},
+		{"pair is forced with few ranks", []string{"2h", "2d", "3c", "3s", "4h", "4d", "5c"}, OnePair, []Rank{Two, Five, Four, Three}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := FindBestLowball27(mustParseCards(t, tt.cards...))
+			if hand == nil {
+				t.Fatal("FindBestLowball27 returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if !reflect.DeepEqual(hand.Tiebreakers, tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreakers)
+			}
+		})
+	}
+
+	if hand := FindBestLowball27(mustParseCards(t, "7h", "5d", "4c", "3s")); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+}
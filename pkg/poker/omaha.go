@@ -0,0 +1,64 @@
+package poker
+
+// Omaha hole card limits: 4-card Omaha (PLO) up to 6-card Omaha.
+const (
+	MinOmahaHoleCards = 4
+	MaxOmahaHoleCards = 6
+)
+
+// validOmahaCards reports whether hole and board form a legal Omaha
+// showdown: 4 to 6 hole cards, 3 to 5 board cards, all valid and distinct.
+func validOmahaCards(hole, board []Card) bool {
+	if len(hole) < MinOmahaHoleCards || len(hole) > MaxOmahaHoleCards {
+		return false
+	}
+	if len(board) < 3 || len(board) > 5 {
+		return false
+	}
+	all := make([]Card, 0, len(hole)+len(board))
+	all = append(append(all, hole...), board...)
+	_, err := NewCardSet(all)
+	return err == nil
+}
+
+// omahaHands calls fn with every legal 5-card Omaha hand: exactly 2 hole
+// cards followed by exactly 3 board cards. The slice is reused between calls.
+func omahaHands(hole, board []Card, fn func(five []Card)) {
+	var five [5]Card
+	for a := 0; a < len(hole); a++ {
+		for b := a + 1; b < len(hole); b++ {
+			five[0], five[1] = hole[a], hole[b]
+			for c := 0; c < len(board); c++ {
+				for d := c + 1; d < len(board); d++ {
+					for e := d + 1; e < len(board); e++ {
+						five[2], five[3], five[4] = board[c], board[d], board[e]
+						fn(five[:])
+					}
+				}
+			}
+		}
+	}
+}
+
+// FindBestOmahaHand finds the best Omaha hand, which must use exactly 2 of
+// the hole cards and exactly 3 of the board cards. It supports 4-, 5- and
+// 6-card Omaha and a board of 3 to 5 cards. The returned Hand lists the two
+// hole cards first, then the three board cards.
+// Returns nil if the card counts are out of range or any card is invalid or
+// repeated.
+func FindBestOmahaHand(hole, board []Card) *Hand {
+	if !validOmahaCards(hole, board) {
+		return nil
+	}
+
+	var best HandRank
+	var bestCards [5]Card
+	omahaHands(hole, board, func(five []Card) {
+		if rank := EvaluateHandRank(five); rank > best {
+			best = rank
+			copy(bestCards[:], five)
+		}
+	})
+
+	return best.Hand(bestCards[:])
+}
@@ -0,0 +1,171 @@
+package poker
+
+import (
+	"testing"
+)
+
+// TestFindBestOmahaHand verifies the exactly-two-hole-cards rule on the
+// classic Omaha traps as well as ordinary made hands.
+func TestFindBestOmahaHand(t *testing.T) {
+	tests := []struct {
+		name     string
+		hole     []string
+		board    []string
+		category HandCategory
+		tiebreak []Rank
+	}{
+		{
+			"four flush on board with one suited hole card is not a flush",
+			[]string{"Ah", "Ad", "7c", "8s"},
+			[]string{"2h", "5h", "9h", "Kh", "3c"},
+			OnePair, []Rank{Ace, King, Nine, Five},
+		},
+		{
+			"four to a straight on board with one connecting hole card is not a straight",
+			[]string{"9d", "Ac", "Ad", "Qd"},
+			[]string{"5c", "6h", "7s", "8c", "Kh"},
+			OnePair, []Rank{Ace, King, Eight, Seven},
+		},
+		{
+			"three aces in hand only play as a pair",
+			[]string{"As", "Ad", "Ac", "2d"},
+			[]string{"Kh", "Ks", "7c", "4d", "3h"},
+			TwoPair, []Rank{Ace, King, Seven},
+		},
+		{
+			"quads on board play as trips",
+			[]string{"Ah", "Ad", "2c", "3c"},
+			[]string{"9h", "9d", "9c", "9s", "Kh"},
+			FullHouse, []Rank{Nine, Ace},
+		},
+		{
+			"board full house improved by a hole pair",
+			[]string{"Ah", "Ad", "Qc", "Js"},
+			[]string{"Kh", "Kd", "Ks", "7c", "7h"},
+			FullHouse, []Rank{King, Ace},
+		},
+		{
+			"flush with two suited hole cards",
+			[]string{"Ah", "2h", "Ks", "Kd"},
+			[]string{"3h", "7h", "9h", "Jc", "Qd"},
+			Flush, []Rank{Ace, Nine, Seven, Three, Two},
+		},
+		{
+			"set beats the flush that needs one hole card",
+			[]string{"Qs", "Qc", "Ah", "4d"},
+			[]string{"Qh", "5h", "9h", "Th", "2c"},
+			ThreeOfAKind, []Rank{Queen, Ten, Nine},
+		},
+		{
+			"five-card Omaha straight",
+			[]string{"Jc", "Td", "2s", "2h", "Kd"},
+			[]string{"9h", "8c", "Qs", "3d"},
+			Straight, []Rank{Queen},
+		},
+		{
+			"six-card Omaha straight flush",
+			[]string{"6s", "7s", "Ad", "Ac", "Kh", "2d"},
+			[]string{"8s", "9s", "Ts", "Ah"},
+			StraightFlush, []Rank{Ten},
+		},
+		{
+			"wheel on the flop",
+			[]string{"Ac", "2d", "Kh", "Ks"},
+			[]string{"3h", "4s", "5d"},
+			Straight, []Rank{Five},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole := mustParseCards(t, tt.hole...)
+			board := mustParseCards(t, tt.board...)
+
+			hand := FindBestOmahaHand(hole, board)
+			if hand == nil {
+				t.Fatal("FindBestOmahaHand returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if len(hand.Tiebreakers) != len(tt.tiebreak) {
+				t.Fatalf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreak)
+			}
+			for i := range tt.tiebreak {
+				if hand.Tiebreakers[i] != tt.tiebreak[i] {
+					t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreak)
+					break
+				}
+			}
+
+			// Exactly two hole cards, listed first, then three board cards
+			if len(hand.Cards) != 5 {
+				t.Fatalf("hand has %d cards, want 5", len(hand.Cards))
+			}
+			for i, card := range hand.Cards {
+				if fromHole := containsCard(hole, card); fromHole != (i < 2) {
+					t.Errorf("card %d (%v) from hole = %v, want %v", i, card, fromHole, i < 2)
+				}
+			}
+		})
+	}
+}
+
+// TestFindBestOmahaHandMatchesBruteForce verifies random deals against
+// evaluating every 2+3 combination with EvaluateHand.
+func TestFindBestOmahaHandMatchesBruteForce(t *testing.T) {
+	src := NewSeededSource(9)
+	for deal := 0; deal < 300; deal++ {
+		holeCount := MinOmahaHoleCards + deal%3
+		boardCount := 3 + deal%3
+
+		deck := NewDeck()
+		deck.Shuffle(src)
+		hole, _ := deck.Deal(holeCount)
+		board, _ := deck.Deal(boardCount)
+
+		var want *Hand
+		for _, two := range Combinations(hole, 2) {
+			for _, three := range Combinations(board, 3) {
+				hand := EvaluateHand(append(append([]Card{}, two...), three...))
+				if want == nil || CompareHands(hand, want) > 0 {
+					want = hand
+				}
+			}
+		}
+
+		got := FindBestOmahaHand(hole, board)
+		if got == nil || CompareHands(got, want) != 0 {
+			t.Fatalf("hole %v board %v: got %v, want %v", hole, board, got, want)
+		}
+	}
+}
+
+// TestFindBestOmahaHandInvalidInput verifies nil for illegal card counts or cards.
+func TestFindBestOmahaHandInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []string
+		board []string
+	}{
+		{"three hole cards", []string{"Ah", "Kh", "Qh"}, []string{"2c", "3c", "4c"}},
+		{"seven hole cards", []string{"Ah", "Kh", "Qh", "Jh", "Th", "9h", "8h"}, []string{"2c", "3c", "4c"}},
+		{"two board cards", []string{"Ah", "Kh", "Qh", "Jh"}, []string{"2c", "3c"}},
+		{"six board cards", []string{"Ah", "Kh", "Qh", "Jh"}, []string{"2c", "3c", "4c", "5c", "6c", "7c"}},
+		{"card in hole and on board", []string{"Ah", "Kh", "Qh", "Jh"}, []string{"Ah", "3c", "4c"}},
+		{"repeated hole card", []string{"Ah", "Ah", "Qh", "Jh"}, []string{"2c", "3c", "4c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if hand := FindBestOmahaHand(mustParseCards(t, tt.hole...), mustParseCards(t, tt.board...)); hand != nil {
+				t.Errorf("FindBestOmahaHand = %v, want nil", hand)
+			}
+		})
+	}
+
+	invalid := []Card{{Rank: Ace, Suit: Hearts}, {Rank: 1, Suit: Hearts}, {Rank: King, Suit: Clubs}, {Rank: Two, Suit: Clubs}}
+	if hand := FindBestOmahaHand(invalid, mustParseCards(t, "3c", "4c", "5c")); hand != nil {
+		t.Errorf("FindBestOmahaHand with an invalid card = %v, want nil", hand)
+	}
+}
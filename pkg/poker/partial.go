@@ -0,0 +1,49 @@
+package poker
+
+// EvaluatePartialHand ranks 1 to 4 cards, such as stud up cards or hold'em
+// hole cards, using the same Category and Tiebreakers semantics as
+// EvaluateHand. Only categories that fit in fewer than 5 cards are possible:
+// High Card, One Pair, Two Pair, Three of a Kind and Four of a Kind.
+// Tiebreakers list the matched ranks first, then the kickers in descending
+// order, so a pair of Kings with an Ace and a Four ranks [King, Ace, Four].
+// Partial hands compare with CompareHands against other hands of the same
+// size; a shorter hand compares only on the tiebreakers both hands have.
+// Returns nil if the input is not 1 to 4 valid, distinct cards.
+func EvaluatePartialHand(cards []Card) *Hand {
+	if len(cards) < 1 || len(cards) > 4 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+
+	counts := rankCounts(cards)
+
+	// Larger groups first, then higher ranks within a group size
+	tiebreakers := make([]Rank, 0, len(counts))
+	for n := 4; n >= 1; n-- {
+		for r := Ace; r >= Two; r-- {
+			if counts[r] == n {
+				tiebreakers = append(tiebreakers, r)
+			}
+		}
+	}
+
+	category := HighCard
+	switch top := counts[tiebreakers[0]]; {
+	case top == 4:
+		category = FourOfAKind
+	case top == 3:
+		category = ThreeOfAKind
+	case top == 2 && len(tiebreakers) == 2 && counts[tiebreakers[1]] == 2:
+		category = TwoPair
+	case top == 2:
+		category = OnePair
+	}
+
+	return &Hand{
+		Cards:       cards,
+		Category:    category,
+		Tiebreakers: tiebreakers,
+	}
+}
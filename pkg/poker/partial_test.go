@@ -0,0 +1,99 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEvaluatePartialHand verifies categories and tiebreakers for 1-4 cards.
+func TestEvaluatePartialHand(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"single card", []string{"Qd"}, HighCard, []Rank{Queen}},
+		{"two high cards", []string{"7c", "Ah"}, HighCard, []Rank{Ace, Seven}},
+		{"pocket pair", []string{"9h", "9s"}, OnePair, []Rank{Nine}},
+		{"three high cards", []string{"4d", "Kc", "Ts"}, HighCard, []Rank{King, Ten, Four}},
+		{"pair with kicker", []string{"3h", "Ad", "3c"}, OnePair, []Rank{Three, Ace}},
+		{"trips", []string{"6h", "6d", "6s"}, ThreeOfAKind, []Rank{Six}},
+		{"four high cards", []string{"2c", "9d", "Jh", "5s"}, HighCard, []Rank{Jack, Nine, Five, Two}},
+		{"pair with two kickers", []string{"Kh", "4c", "Kd", "Ac"}, OnePair, []Rank{King, Ace, Four}},
+		{"two pair", []string{"5h", "Jd", "5c", "Js"}, TwoPair, []Rank{Jack, Five}},
+		{"trips with kicker", []string{"8h", "8d", "2s", "8c"}, ThreeOfAKind, []Rank{Eight, Two}},
+		{"quads", []string{"Th", "Td", "Tc", "Ts"}, FourOfAKind, []Rank{Ten}},
+		{"four to a straight flush is high card", []string{"5h", "6h", "7h", "8h"}, HighCard, []Rank{Eight, Seven, Six, Five}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards := mustParseCards(t, tt.cards...)
+			hand := EvaluatePartialHand(cards)
+			if hand == nil {
+				t.Fatal("EvaluatePartialHand returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if !reflect.DeepEqual(hand.Tiebreakers, tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreakers)
+			}
+			if !reflect.DeepEqual(hand.Cards, cards) {
+				t.Errorf("Cards = %v, want %v", hand.Cards, cards)
+			}
+		})
+	}
+}
+
+// TestEvaluatePartialHandInvalidInput verifies nil for bad card counts or cards.
+func TestEvaluatePartialHandInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"no cards", nil},
+		{"five cards", mustParseCards(t, "Ah", "Kh", "Qh", "Jh", "Th")},
+		{"repeated card", mustParseCards(t, "Ah", "Ah")},
+		{"invalid rank", []Card{{Rank: 1, Suit: Hearts}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if hand := EvaluatePartialHand(tt.cards); hand != nil {
+				t.Errorf("EvaluatePartialHand = %v, want nil", hand)
+			}
+		})
+	}
+}
+
+// TestComparePartialHands verifies that partial hands order correctly with
+// CompareHands.
+func TestComparePartialHands(t *testing.T) {
+	partial := func(cards ...string) *Hand { return EvaluatePartialHand(mustParseCards(t, cards...)) }
+
+	tests := []struct {
+		name     string
+		hand1    *Hand
+		hand2    *Hand
+		expected int
+	}{
+		{"pair beats ace-king", partial("2c", "2d"), partial("Ah", "Kh"), 1},
+		{"higher pair wins", partial("Qc", "Qd"), partial("Jh", "Js"), 1},
+		{"kicker decides", partial("Ah", "Kd", "7c"), partial("As", "Kc", "6d"), 1},
+		{"pair kicker decides", partial("9h", "9d", "Ac"), partial("9c", "9s", "Kd"), 1},
+		{"trips beat two pair", partial("3h", "3d", "3c", "2s"), partial("Ah", "Ad", "Kc", "Ks"), 1},
+		{"quads beat trips", partial("4h", "4d", "4c", "4s"), partial("Ah", "Ad", "Ac", "Ks"), 1},
+		{"suits do not matter", partial("Th", "9h"), partial("Tc", "9d"), 0},
+		{"low pair loses", partial("5h", "5d", "Kc"), partial("8h", "8c", "2d"), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareHands(tt.hand1, tt.hand2); got != tt.expected {
+				t.Errorf("CompareHands(%v, %v) = %d, want %d", tt.hand1, tt.hand2, got, tt.expected)
+			}
+		})
+	}
+}
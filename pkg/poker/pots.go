@@ -0,0 +1,244 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Stake is one player's part in a hand's betting: the total chips they put
+// in across every street, and whether they folded.
+type Stake struct {
+	Contributed int
+	Folded      bool
+}
+
+// BuildPots divides the chips from stakes into a main pot and side pots.
+// Each all-in amount among the players still in the hand caps a pot: every
+// player's chips up to that level go in, and only unfolded players who put
+// in at least that much are eligible. Folded players' chips stay in the
+// pots but they are never eligible.
+//
+// A bet that no other player matched is not part of any pot; it is
+// returned to its owner in refunds, which holds one entry per stake.
+// Pots are ordered from the main pot to the last side pot, and their
+// Eligible lists are in stakes order.
+//
+// Returns an error if a contribution is negative or every player folded.
+func BuildPots(stakes []Stake) (pots []Pot, refunds []int, err error) {
+	contributed := make([]int, len(stakes))
+	live := 0
+	for i, s := range stakes {
+		if s.Contributed < 0 {
+			return nil, nil, fmt.Errorf("player %d contributed a negative amount: %d", i+1, s.Contributed)
+		}
+		contributed[i] = s.Contributed
+		if !s.Folded {
+			live++
+		}
+	}
+	if live == 0 {
+		return nil, nil, fmt.Errorf("every player folded")
+	}
+
+	// Return the part of the largest contribution nobody else matched
+	refunds = make([]int, len(stakes))
+	top, second := 0, 0
+	for i, c := range contributed {
+		if c > contributed[top] {
+			second = contributed[top]
+			top = i
+		} else if i != top && c > second {
+			second = c
+		}
+	}
+	refunds[top] = contributed[top] - second
+	contributed[top] = second
+
+	// Every distinct amount a live player put in caps a pot
+	var levels []int
+	for i, s := range stakes {
+		if !s.Folded && contributed[i] > 0 && !slices.Contains(levels, contributed[i]) {
+			levels = append(levels, contributed[i])
+		}
+	}
+	slices.Sort(levels)
+
+	prev := 0
+	for _, level := range levels {
+		pot := Pot{Eligible: []int{}}
+		for i, c := range contributed {
+			pot.Amount += min(c, level) - min(c, prev)
+			if !stakes[i].Folded && c >= level {
+				pot.Eligible = append(pot.Eligible, i)
+			}
+		}
+		pots = append(pots, pot)
+		prev = level
+	}
+
+	// Folded chips above every live player's level join the last pot
+	extra := 0
+	for _, c := range contributed {
+		extra += max(c-prev, 0)
+	}
+	switch {
+	case extra > 0 && len(pots) > 0:
+		pots[len(pots)-1].Amount += extra
+	case extra > 0:
+		// Nobody still in the hand put in chips
+		pot := Pot{Amount: extra, Eligible: []int{}}
+		for i, s := range stakes {
+			if !s.Folded {
+				pot.Eligible = append(pot.Eligible, i)
+			}
+		}
+		pots = append(pots, pot)
+	}
+	return pots, refunds, nil
+}
+
+// Settlement is the outcome of a hand: the pots awarded, the uncalled bets
+// returned and each player's winnings.
+type Settlement struct {
+	Pots    []PotResult
+	Refunds []int // Uncalled chips returned to each player
+	Payouts []int // Chips won from the pots by each player, not counting Refunds
+}
+
+// SettlePots builds the pots from stakes with BuildPots and awards them.
+// showdown ranks the players and must list them in the same order as
+// stakes; it may be nil when only one player has not folded, who then
+// wins every pot without a showdown. lows, if not nil, holds each player's
+// qualifying low hand (nil for none) and splits every pot hi-lo as in
+// ShowdownResult.AwardHiLo. Odd chips are handed out according to rule.
+//
+// Returns an error if the stakes are invalid, or showdown or lows do not
+// match the players in stakes.
+func SettlePots(stakes []Stake, showdown *ShowdownResult, lows []*LowHand, rule OddChipRule) (*Settlement, error) {
+	pots, refunds, err := BuildPots(stakes)
+	if err != nil {
+		return nil, err
+	}
+
+	var live []int
+	for i, s := range stakes {
+		if !s.Folded {
+			live = append(live, i)
+		}
+	}
+
+	if showdown == nil {
+		if len(live) != 1 {
+			return nil, fmt.Errorf("a showdown is needed to settle %d live players", len(live))
+		}
+		// Everyone else folded: the last player takes every pot
+		res := &Settlement{Refunds: refunds, Payouts: make([]int, len(stakes))}
+		for _, pot := range pots {
+			payouts := make([]int, len(stakes))
+			payouts[live[0]] = pot.Amount
+			res.Payouts[live[0]] += pot.Amount
+			res.Pots = append(res.Pots, PotResult{Amount: pot.Amount, Winners: live, Payouts: payouts})
+		}
+		return res, nil
+	}
+
+	if len(showdown.Hands) != len(stakes) {
+		return nil, fmt.Errorf("showdown has %d players but there are %d stakes", len(showdown.Hands), len(stakes))
+	}
+	for i, s := range stakes {
+		if (showdown.Hands[i] == nil) != s.Folded {
+			return nil, fmt.Errorf("player %d folded status does not match the showdown", i+1)
+		}
+	}
+
+	var results []PotResult
+	var payouts []int
+	if lows == nil {
+		results, payouts, err = showdown.Award(pots, rule)
+	} else {
+		results, payouts, err = showdown.AwardHiLo(pots, lows, rule)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Settlement{Pots: results, Refunds: refunds, Payouts: payouts}, nil
+}
+
+// SettleTable shows down and settles a hand with stakes and players listed
+// in seat order around the table, the dealer button at seat button. It
+// passes them to Showdown and SettlePots in order from left of the button,
+// so odd chips go by OddChipLeftOfButton, and skips the showdown when only
+// one player has not folded. A player's Folded is taken from their stake.
+// The settlement, its pot winners and the showdown hands (nil for folded
+// players, and for everyone without a showdown) are returned in seat order.
+//
+// Returns an error if stakes and players differ in length, the button is
+// not a seat, or Showdown or SettlePots fail.
+func SettleTable(board []Card, button int, stakes []Stake, players []ShowdownPlayer) (*Settlement, []*Hand, error) {
+	n := len(stakes)
+	if len(players) != n {
+		return nil, nil, fmt.Errorf("got %d stakes but %d players", n, len(players))
+	}
+	if button < 0 || button >= n {
+		return nil, nil, fmt.Errorf("button seat %d does not exist", button)
+	}
+
+	order := make([]int, n)
+	fromButton := make([]Stake, n)
+	showing := make([]ShowdownPlayer, n)
+	live := 0
+	for k := range order {
+		seat := (button + 1 + k) % n
+		order[k] = seat
+		fromButton[k] = stakes[seat]
+		showing[k] = players[seat]
+		showing[k].Folded = stakes[seat].Folded
+		if !stakes[seat].Folded {
+			live++
+		}
+	}
+
+	var showdown *ShowdownResult
+	if live > 1 {
+		var err error
+		if showdown, err = Showdown(board, showing...); err != nil {
+			return nil, nil, err
+		}
+	}
+	settlement, err := SettlePots(fromButton, showdown, nil, OddChipLeftOfButton)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Map results from button order back to seats
+	bySeat := func(values []int) []int {
+		seats := make([]int, n)
+		for k, v := range values {
+			seats[order[k]] = v
+		}
+		return seats
+	}
+	res := &Settlement{
+		Refunds: bySeat(settlement.Refunds),
+		Payouts: bySeat(settlement.Payouts),
+	}
+	for _, pot := range settlement.Pots {
+		winners := make([]int, len(pot.Winners))
+		for k, i := range pot.Winners {
+			winners[k] = order[i]
+		}
+		slices.Sort(winners)
+		res.Pots = append(res.Pots, PotResult{
+			Amount:  pot.Amount,
+			Winners: winners,
+			Payouts: bySeat(pot.Payouts),
+		})
+	}
+	hands := make([]*Hand, n)
+	if showdown != nil {
+		for k, seat := range order {
+			hands[seat] = showdown.Hands[k]
+		}
+	}
+	return res, hands, nil
+}
@@ -0,0 +1,456 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// stakes builds live stakes from contributions; negative values mark
+// folded players contributing the absolute amount.
+func stakes(contributions ...int) []Stake {
+	s := make([]Stake, len(contributions))
+	for i, c := range contributions {
+		if c < 0 {
+			s[i] = Stake{Contributed: -c, Folded: true}
+		} else {
+			s[i] = Stake{Contributed: c}
+		}
+	}
+	return s
+}
+
+// TestBuildPots verifies main and side pot construction, eligibility and
+// uncalled bet returns.
+func TestBuildPots(t *testing.T) {
+	tests := []struct {
+		name    string
+		stakes  []Stake
+		pots    []Pot
+		refunds []int
+	}{
+		{
+			"heads-up called bet",
+			stakes(100, 100),
+			[]Pot{{Amount: 200, Eligible: []int{0, 1}}},
+			[]int{0, 0},
+		},
+		{
+			"uncalled part of a bet is returned",
+			stakes(100, 40),
+			[]Pot{{Amount: 80, Eligible: []int{0, 1}}},
+			[]int{60, 0},
+		},
+		{
+			"everyone folds to the big blind",
+			stakes(-50, 100),
+			[]Pot{{Amount: 100, Eligible: []int{1}}},
+			[]int{0, 50},
+		},
+		{
+			"bet with no callers",
+			stakes(-20, 300, -20),
+			[]Pot{{Amount: 60, Eligible: []int{1}}},
+			[]int{0, 280, 0},
+		},
+		{
+			"one short all-in",
+			stakes(50, 200, 200),
+			[]Pot{{Amount: 150, Eligible: []int{0, 1, 2}}, {Amount: 300, Eligible: []int{1, 2}}},
+			[]int{0, 0, 0},
+		},
+		{
+			"two all-ins at different levels",
+			stakes(30, 70, 100, 100),
+			[]Pot{
+				{Amount: 120, Eligible: []int{0, 1, 2, 3}},
+				{Amount: 120, Eligible: []int{1, 2, 3}},
+				{Amount: 60, Eligible: []int{2, 3}},
+			},
+			[]int{0, 0, 0, 0},
+		},
+		{
+			"equal all-ins share a pot",
+			stakes(50, 50, 100, 100),
+			[]Pot{{Amount: 200, Eligible: []int{0, 1, 2, 3}}, {Amount: 100, Eligible: []int{2, 3}}},
+			[]int{0, 0, 0, 0},
+		},
+		{
+			"all-in player overbet by the last caller",
+			stakes(50, 300),
+			[]Pot{{Amount: 100, Eligible: []int{0, 1}}},
+			[]int{0, 250},
+		},
+		{
+			"folded chips stay in the pot",
+			stakes(100, 100, -40),
+			[]Pot{{Amount: 240, Eligible: []int{0, 1}}},
+			[]int{0, 0, 0},
+		},
+		{
+			"folded player split across pots",
+			stakes(50, 200, 200, -100),
+			[]Pot{{Amount: 200, Eligible: []int{0, 1, 2}}, {Amount: 350, Eligible: []int{1, 2}}},
+			[]int{0, 0, 0, 0},
+		},
+		{
+			"folded chips above every live level",
+			stakes(50, -200, -150),
+			[]Pot{{Amount: 350, Eligible: []int{0}}},
+			[]int{0, 50, 0},
+		},
+		{
+			"folded raiser returns nothing once called",
+			stakes(50, 150, -150),
+			[]Pot{{Amount: 150, Eligible: []int{0, 1}}, {Amount: 200, Eligible: []int{1}}},
+			[]int{0, 0, 0},
+		},
+		{
+			"antes from folded players only",
+			stakes(0, -10, -10),
+			[]Pot{{Amount: 20, Eligible: []int{0}}},
+			[]int{0, 0, 0},
+		},
+		{
+			"no chips",
+			stakes(0, 0),
+			nil,
+			[]int{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pots, refunds, err := BuildPots(tt.stakes)
+			if err != nil {
+				t.Fatalf("BuildPots: %v", err)
+			}
+			if !reflect.DeepEqual(pots, tt.pots) {
+				t.Errorf("pots = %v, want %v", pots, tt.pots)
+			}
+			if !reflect.DeepEqual(refunds, tt.refunds) {
+				t.Errorf("refunds = %v, want %v", refunds, tt.refunds)
+			}
+
+			// Every chip ends up in a pot or back with its owner
+			in, out := 0, 0
+			for _, s := range tt.stakes {
+				in += s.Contributed
+			}
+			for _, pot := range pots {
+				out += pot.Amount
+			}
+			for _, r := range refunds {
+				out += r
+			}
+			if in != out {
+				t.Errorf("%d chips in, %d accounted for", in, out)
+			}
+		})
+	}
+}
+
+// TestBuildPotsErrors verifies rejection of invalid stakes.
+func TestBuildPotsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		stakes []Stake
+	}{
+		{"no players", nil},
+		{"negative contribution", []Stake{{Contributed: -5}, {Contributed: 10}}},
+		{"everyone folded", stakes(-10, -20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := BuildPots(tt.stakes); err == nil {
+				t.Error("BuildPots should fail")
+			}
+		})
+	}
+}
+
+// TestSettlePots verifies that side pots go to the best eligible hands,
+// with ties, odd chips and hi-lo splits.
+func TestSettlePots(t *testing.T) {
+	board := mustParseCards(t, "2h", "5d", "7c", "Js", "Kd")
+	trips := []string{"Kh", "Ks"}    // Three Kings, no low
+	aces := []string{"As", "Ah"}     // Pair of Aces
+	queens1 := []string{"Qc", "Qd"}  // Pair of Queens
+	queens2 := []string{"Qh", "Qs"}  // Pair of Queens, ties queens1
+	aceLow := []string{"Ac", "3s"}   // Ace high, 7-5-3-2-A low
+	sevenLow := []string{"3c", "4c"} // Seven high, 7-5-4-3-2 low
+	trash := []string{"9d", "Td"}    // King high, no low
+
+	tests := []struct {
+		name    string
+		holes   [][]string
+		stakes  []Stake
+		hiLo    bool
+		rule    OddChipRule
+		winners [][]int
+		payouts []int
+		refunds []int
+	}{
+		{
+			"short stack wins the main pot only",
+			[][]string{trips, aces, queens1},
+			stakes(50, 200, 200),
+			false, OddChipLeftOfButton,
+			[][]int{{0}, {1}},
+			[]int{150, 300, 0},
+			[]int{0, 0, 0},
+		},
+		{
+			"big stack wins everything",
+			[][]string{queens1, trips, aces},
+			stakes(50, 200, 200),
+			false, OddChipLeftOfButton,
+			[][]int{{1}, {1}},
+			[]int{0, 450, 0},
+			[]int{0, 0, 0},
+		},
+		{
+			"uncalled bet returned to the winner",
+			[][]string{aces, trips},
+			stakes(80, 500),
+			false, OddChipLeftOfButton,
+			[][]int{{1}},
+			[]int{0, 160},
+			[]int{0, 420},
+		},
+		{
+			"tied side pot with an odd chip left of the button",
+			[][]string{trips, queens2, queens1, trash},
+			[]Stake{{Contributed: 50}, {Contributed: 100}, {Contributed: 100}, {Contributed: 75, Folded: true}},
+			false, OddChipLeftOfButton,
+			[][]int{{0}, {1, 2}},
+			[]int{200, 63, 62, 0},
+			[]int{0, 0, 0, 0},
+		},
+		{
+			"tied side pot with an odd chip to the high card",
+			[][]string{trips, queens1, queens2, trash},
+			[]Stake{{Contributed: 50}, {Contributed: 100}, {Contributed: 100}, {Contributed: 75, Folded: true}},
+			false, OddChipHighCard,
+			[][]int{{0}, {1, 2}},
+			[]int{200, 62, 63, 0},
+			[]int{0, 0, 0, 0},
+		},
+		{
+			"overbet returned above two equal all-ins",
+			[][]string{queens1, queens2, aces},
+			stakes(51, 51, 200),
+			false, OddChipLeftOfButton,
+			[][]int{{2}},
+			[]int{0, 0, 153},
+			[]int{0, 0, 149},
+		},
+		{
+			"hi-lo split in every pot",
+			[][]string{trips, aceLow, sevenLow},
+			stakes(200, 50, 200),
+			true, OddChipLeftOfButton,
+			[][]int{{0}, {0}},
+			[]int{225, 75, 150},
+			[]int{0, 0, 0},
+		},
+		{
+			"no low scoops the pot",
+			[][]string{trips, trash},
+			stakes(101, 101),
+			true, OddChipLeftOfButton,
+			[][]int{{0}},
+			[]int{202, 0},
+			[]int{0, 0},
+		},
+		{
+			"odd chip from halving goes high",
+			[][]string{trips, sevenLow, trash},
+			[]Stake{{Contributed: 50}, {Contributed: 50}, {Contributed: 1, Folded: true}},
+			true, OddChipLeftOfButton,
+			[][]int{{0}},
+			[]int{51, 50, 0},
+			[]int{0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := make([]ShowdownPlayer, len(tt.holes))
+			var lows []*LowHand
+			if tt.hiLo {
+				lows = make([]*LowHand, len(tt.holes))
+			}
+			for i, hole := range tt.holes {
+				players[i] = ShowdownPlayer{Hole: mustParseCards(t, hole...), Folded: tt.stakes[i].Folded}
+				if tt.hiLo && !tt.stakes[i].Folded {
+					lows[i] = FindBestLowHand(append(mustParseCards(t, hole...), board...))
+				}
+			}
+			sd, err := Showdown(board, players...)
+			if err != nil {
+				t.Fatalf("Showdown: %v", err)
+			}
+
+			res, err := SettlePots(tt.stakes, sd, lows, tt.rule)
+			if err != nil {
+				t.Fatalf("SettlePots: %v", err)
+			}
+			for k, pot := range res.Pots {
+				if k < len(tt.winners) && !reflect.DeepEqual(pot.Winners, tt.winners[k]) {
+					t.Errorf("pot %d Winners = %v, want %v", k, pot.Winners, tt.winners[k])
+				}
+			}
+			if !reflect.DeepEqual(res.Payouts, tt.payouts) {
+				t.Errorf("Payouts = %v, want %v", res.Payouts, tt.payouts)
+			}
+			if !reflect.DeepEqual(res.Refunds, tt.refunds) {
+				t.Errorf("Refunds = %v, want %v", res.Refunds, tt.refunds)
+			}
+
+			in, out := 0, 0
+			for i, s := range tt.stakes {
+				in += s.Contributed
+				out += res.Payouts[i] + res.Refunds[i]
+			}
+			if in != out {
+				t.Errorf("%d chips in, %d paid out", in, out)
+			}
+		})
+	}
+}
+
+// TestSettlePotsHiLoWinners verifies the low winners reported per pot.
+func TestSettlePotsHiLoWinners(t *testing.T) {
+	board := mustParseCards(t, "2h", "5d", "7c", "Js", "Kd")
+	holes := [][]string{{"Kh", "Ks"}, {"Ac", "3s"}, {"3c", "4c"}}
+
+	players := make([]ShowdownPlayer, len(holes))
+	lows := make([]*LowHand, len(holes))
+	for i, hole := range holes {
+		players[i] = ShowdownPlayer{Hole: mustParseCards(t, hole...)}
+		lows[i] = FindBestLowHand(append(mustParseCards(t, hole...), board...))
+	}
+	sd, err := Showdown(board, players...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := SettlePots(stakes(200, 50, 200), sd, lows, OddChipLeftOfButton)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int{{1}, {2}}
+	for k, pot := range res.Pots {
+		if !reflect.DeepEqual(pot.LowWinners, want[k]) {
+			t.Errorf("pot %d LowWinners = %v, want %v", k, pot.LowWinners, want[k])
+		}
+	}
+}
+
+// TestSettlePotsWithoutShowdown verifies that the last live player wins
+// every pot when the others fold.
+func TestSettlePotsWithoutShowdown(t *testing.T) {
+	res, err := SettlePots(stakes(-50, 300, -100), nil, nil, OddChipLeftOfButton)
+	if err != nil {
+		t.Fatalf("SettlePots: %v", err)
+	}
+	if want := []int{0, 250, 0}; !reflect.DeepEqual(res.Payouts, want) {
+		t.Errorf("Payouts = %v, want %v", res.Payouts, want)
+	}
+	if want := []int{0, 200, 0}; !reflect.DeepEqual(res.Refunds, want) {
+		t.Errorf("Refunds = %v, want %v", res.Refunds, want)
+	}
+}
+
+// TestSettlePotsErrors verifies rejection of mismatched inputs.
+func TestSettlePotsErrors(t *testing.T) {
+	board := mustParseCards(t, "2h", "5d", "7c", "Js", "Kd")
+	sd, err := Showdown(board,
+		ShowdownPlayer{Hole: mustParseCards(t, "Kh", "Ks")},
+		ShowdownPlayer{Hole: mustParseCards(t, "Ah", "As")},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		stakes   []Stake
+		showdown *ShowdownResult
+		lows     []*LowHand
+	}{
+		{"showdown needed", stakes(100, 100), nil, nil},
+		{"folded status mismatch", stakes(100, -100), sd, nil},
+		{"player count mismatch", stakes(100, 100, 100), sd, nil},
+		{"low count mismatch", stakes(100, 100), sd, []*LowHand{nil}},
+		{"invalid stakes", stakes(-1, -1), sd, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SettlePots(tt.stakes, tt.showdown, tt.lows, OddChipLeftOfButton); err == nil {
+				t.Error("SettlePots should fail")
+			}
+		})
+	}
+}
+
+// TestSettleTable verifies that results come back in seat order, with the
+// odd chip going to the first winner left of the button.
+func TestSettleTable(t *testing.T) {
+	board := mustParseCards(t, "2h", "5d", "7c", "Js", "Kd")
+	players := []ShowdownPlayer{
+		player(t, "Qc", "Qd"), // Pair of Queens
+		player(t, "Qh", "Qs"), // Pair of Queens, ties seat 0
+		player(t, "9d", "Td"), // Folds
+	}
+
+	tests := []struct {
+		name    string
+		button  int
+		stakes  []Stake
+		payouts []int
+		refunds []int
+		hands   []bool
+	}{
+		{"odd chip to seat 1", 0, stakes(101, 101, -51), []int{126, 127, 0}, []int{0, 0, 0}, []bool{true, true, false}},
+		{"odd chip past a folded seat", 1, stakes(101, 101, -51), []int{127, 126, 0}, []int{0, 0, 0}, []bool{true, true, false}},
+		{"no showdown", 2, stakes(-20, 60, -10), []int{0, 50, 0}, []int{0, 40, 0}, []bool{false, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, hands, err := SettleTable(board, tt.button, tt.stakes, players)
+			if err != nil {
+				t.Fatalf("SettleTable: %v", err)
+			}
+			if !reflect.DeepEqual(res.Payouts, tt.payouts) {
+				t.Errorf("Payouts = %v, want %v", res.Payouts, tt.payouts)
+			}
+			if !reflect.DeepEqual(res.Refunds, tt.refunds) {
+				t.Errorf("Refunds = %v, want %v", res.Refunds, tt.refunds)
+			}
+			for seat, want := range tt.hands {
+				if (hands[seat] != nil) != want {
+					t.Errorf("seat %d has hand %v, want one: %v", seat, hands[seat], want)
+				}
+			}
+			for _, pot := range res.Pots {
+				if !reflect.DeepEqual(pot.Payouts, res.Payouts) {
+					t.Errorf("pot Payouts = %v, want %v", pot.Payouts, res.Payouts)
+				}
+			}
+		})
+	}
+
+	if res, _, _ := SettleTable(board, 0, stakes(101, 101, -51), players); !reflect.DeepEqual(res.Pots[0].Winners, []int{0, 1}) {
+		t.Errorf("Winners = %v, want [0 1]", res.Pots[0].Winners)
+	}
+	if _, _, err := SettleTable(board, 3, stakes(10, 10, 10), players); err == nil {
+		t.Error("SettleTable should reject a button outside the table")
+	}
+	if _, _, err := SettleTable(board, 0, stakes(10, 10), players); err == nil {
+		t.Error("SettleTable should reject mismatched stakes and players")
+	}
+}
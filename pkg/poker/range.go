@@ -0,0 +1,478 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Combo is one specific pair of hole cards in a Range, together with a
+// weight giving how often it is played (1 means always).
+type Combo struct {
+	Cards  [2]Card // Higher rank first; for pairs, lower suit value first
+	Weight float64 // Relative frequency, greater than 0 and at most 1
+}
+
+// newCombo orders two cards canonically and attaches a weight.
+func newCombo(a, b Card, weight float64) Combo {
+	if b.Rank > a.Rank || (b.Rank == a.Rank && b.Suit < a.Suit) {
+		a, b = b, a
+	}
+	return Combo{Cards: [2]Card{a, b}, Weight: weight}
+}
+
+// CardSet returns the combo's two cards as a CardSet.
+func (c Combo) CardSet() CardSet {
+	var set CardSet
+	set.Add(c.Cards[0].Index())
+	set.Add(c.Cards[1].Index())
+	return set
+}
+
+// String returns the combo in card notation (e.g., "AhKh").
+func (c Combo) String() string {
+	return c.Cards[0].String() + c.Cards[1].String()
+}
+
+// Range is a set of weighted hole-card combinations, usually built from
+// standard range notation with ParseRange.
+type Range struct {
+	combos []Combo
+	index  map[CardSet]int // Position of each combo in combos
+}
+
+// NewRange creates an empty range.
+func NewRange() *Range {
+	return &Range{index: make(map[CardSet]int)}
+}
+
+// add inserts a combo, replacing the weight if it is already present.
+func (r *Range) add(c Combo) {
+	key := c.CardSet()
+	if i, ok := r.index[key]; ok {
+		r.combos[i].Weight = c.Weight
+		return
+	}
+	r.index[key] = len(r.combos)
+	r.combos = append(r.combos, c)
+}
+
+// Add inserts the combination of two hole cards with the given weight,
+// replacing the weight if the combination is already in the range.
+// Returns an error for invalid cards, a repeated card, or a weight outside (0, 1].
+func (r *Range) Add(a, b Card, weight float64) error {
+	if !a.Index().Valid() || !b.Index().Valid() {
+		return fmt.Errorf("invalid combo: %v%v", a, b)
+	}
+	if a == b {
+		return fmt.Errorf("invalid combo: %v%v uses the same card twice", a, b)
+	}
+	if weight <= 0 || weight > 1 {
+		return fmt.Errorf("invalid weight %v: must be greater than 0 and at most 1", weight)
+	}
+	r.add(newCombo(a, b, weight))
+	return nil
+}
+
+// Len returns the number of combinations in the range.
+func (r *Range) Len() int {
+	return len(r.combos)
+}
+
+// Combos returns a copy of the combinations in the range, in the order
+// they were added.
+func (r *Range) Combos() []Combo {
+	combos := make([]Combo, len(r.combos))
+	copy(combos, r.combos)
+	return combos
+}
+
+// Without returns a new range containing only the combinations that share
+// no card with dead, such as known board cards or another player's hand.
+func (r *Range) Without(dead CardSet) *Range {
+	result := NewRange()
+	for _, c := range r.combos {
+		if c.CardSet().Intersect(dead) == 0 {
+			result.add(c)
+		}
+	}
+	return result
+}
+
+// classKind distinguishes the shapes of a starting-hand class.
+type classKind int
+
+const (
+	pairClass    classKind = iota // e.g. "QQ"
+	suitedClass                   // e.g. "AKs"
+	offsuitClass                  // e.g. "AKo"
+	anyClass                      // e.g. "AK", suited and offsuit together
+)
+
+// handClass is a starting-hand class such as "AKs" or "TT".
+type handClass struct {
+	high, low Rank
+	kind      classKind
+}
+
+// String returns the class in range notation (e.g., "AKs").
+func (c handClass) String() string {
+	s := c.high.String() + c.low.String()
+	switch c.kind {
+	case suitedClass:
+		s += "s"
+	case offsuitClass:
+		s += "o"
+	}
+	return s
+}
+
+// size returns the number of combinations in a full class.
+func (c handClass) size() int {
+	switch c.kind {
+	case pairClass:
+		return 6
+	case suitedClass:
+		return 4
+	case offsuitClass:
+		return 12
+	default:
+		return 16
+	}
+}
+
+// combos expands the class to its concrete hole-card combinations.
+func (c handClass) combos(weight float64) []Combo {
+	var combos []Combo
+	for s1 := Hearts; s1 <= Spades; s1++ {
+		for s2 := Hearts; s2 <= Spades; s2++ {
+			include := false
+			switch c.kind {
+			case pairClass:
+				include = s1 < s2
+			case suitedClass:
+				include = s1 == s2
+			case offsuitClass:
+				include = s1 != s2
+			case anyClass:
+				include = true
+			}
+			if include {
+				combos = append(combos, newCombo(Card{Rank: c.high, Suit: s1}, Card{Rank: c.low, Suit: s2}, weight))
+			}
+		}
+	}
+	return combos
+}
+
+// comboClass returns the class a combo belongs to (never anyClass).
+func comboClass(c Combo) handClass {
+	class := handClass{high: c.Cards[0].Rank, low: c.Cards[1].Rank, kind: offsuitClass}
+	switch {
+	case class.high == class.low:
+		class.kind = pairClass
+	case c.Cards[0].Suit == c.Cards[1].Suit:
+		class.kind = suitedClass
+	}
+	return class
+}
+
+// parseHandClass parses a class such as "QQ", "AKs", "AKo" or "AK".
+func parseHandClass(s string) (handClass, error) {
+	if len(s) < 2 || len(s) > 3 {
+		return handClass{}, fmt.Errorf("invalid hand class: %q", s)
+	}
+
+	high, err := parseRank(s[:1])
+	if err != nil {
+		return handClass{}, err
+	}
+	low, err := parseRank(s[1:2])
+	if err != nil {
+		return handClass{}, err
+	}
+	if low > high {
+		high, low = low, high
+	}
+
+	class := handClass{high: high, low: low, kind: anyClass}
+	if high == low {
+		if len(s) == 3 {
+			return handClass{}, fmt.Errorf("invalid hand class: %q (pairs cannot be suited or offsuit)", s)
+		}
+		class.kind = pairClass
+		return class, nil
+	}
+
+	if len(s) == 3 {
+		switch s[2] {
+		case 's', 'S':
+			class.kind = suitedClass
+		case 'o', 'O':
+			class.kind = offsuitClass
+		default:
+			return handClass{}, fmt.Errorf("invalid hand class: %q (expected 's' or 'o' suffix)", s)
+		}
+	}
+	return class, nil
+}
+
+// parseRangeToken expands one comma-separated element of range notation.
+func parseRangeToken(token string) ([]Combo, error) {
+	body := token
+	weight := 1.0
+	if i := strings.IndexByte(token, ':'); i >= 0 {
+		body = token[:i]
+		w, err := strconv.ParseFloat(strings.TrimSpace(token[i+1:]), 64)
+		if err != nil || w <= 0 || w > 1 {
+			return nil, fmt.Errorf("invalid weight in %q: must be greater than 0 and at most 1", token)
+		}
+		weight = w
+	}
+	body = strings.TrimSpace(body)
+
+	// Specific combo, e.g. "AhKh"
+	if len(body) == 4 {
+		a, errA := ParseCard(body[:2])
+		b, errB := ParseCard(body[2:])
+		if errA == nil && errB == nil {
+			if a == b {
+				return nil, fmt.Errorf("invalid combo %q: uses the same card twice", body)
+			}
+			return []Combo{newCombo(a, b, weight)}, nil
+		}
+	}
+
+	var classes []handClass
+
+	switch {
+	case strings.Contains(body, "-"):
+		// Dash range, e.g. "22-66" or "A2s-A5s"
+		parts := strings.Split(body, "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid range %q", body)
+		}
+		from, err := parseHandClass(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseHandClass(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if from.kind != to.kind {
+			return nil, fmt.Errorf("invalid range %q: both ends must be the same kind of hand", body)
+		}
+
+		if from.kind == pairClass {
+			lo, hi := from.high, to.high
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			for r := lo; r <= hi; r++ {
+				classes = append(classes, handClass{high: r, low: r, kind: pairClass})
+			}
+		} else {
+			if from.high != to.high {
+				return nil, fmt.Errorf("invalid range %q: both ends must share the same high card", body)
+			}
+			lo, hi := from.low, to.low
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			for r := lo; r <= hi; r++ {
+				classes = append(classes, handClass{high: from.high, low: r, kind: from.kind})
+			}
+		}
+
+	case strings.HasSuffix(body, "+"):
+		// Plus range, e.g. "TT+" or "ATs+"
+		base, err := parseHandClass(strings.TrimSuffix(body, "+"))
+		if err != nil {
+			return nil, err
+		}
+		if base.kind == pairClass {
+			for r := base.high; r <= Ace; r++ {
+				classes = append(classes, handClass{high: r, low: r, kind: pairClass})
+			}
+		} else {
+			for r := base.low; r < base.high; r++ {
+				classes = append(classes, handClass{high: base.high, low: r, kind: base.kind})
+			}
+		}
+
+	default:
+		class, err := parseHandClass(body)
+		if err != nil {
+			return nil, err
+		}
+		classes = append(classes, class)
+	}
+
+	var combos []Combo
+	for _, class := range classes {
+		combos = append(combos, class.combos(weight)...)
+	}
+	return combos, nil
+}
+
+// ParseRange parses standard hand range notation into a Range.
+// Elements are separated by commas and may be:
+//   - pairs and classes: "QQ", "AKs", "AKo", "AK" (suited and offsuit)
+//   - plus ranges: "TT+" (tens or better), "ATs+" (ATs, AJs, AQs, AKs)
+//   - dash ranges: "22-66", "A2s-A5s"
+//   - specific combos: "AhKh"
+//
+// Any element may carry a weight, e.g. "AKs:0.5". When a combination is
+// listed more than once, the last weight wins.
+func ParseRange(s string) (*Range, error) {
+	r := NewRange()
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		combos, err := parseRangeToken(token)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range combos {
+			r.add(c)
+		}
+	}
+	return r, nil
+}
+
+// formatWeight returns the ":weight" suffix for non-default weights.
+func formatWeight(weight float64) string {
+	if weight == 1 {
+		return ""
+	}
+	return ":" + strconv.FormatFloat(weight, 'g', -1, 64)
+}
+
+// rankRun is a run of adjacent ranks sharing one weight, highest first.
+type rankRun struct {
+	top, bottom Rank
+	weight      float64
+}
+
+// collectRuns groups present ranks (scanned from high to low) into runs of
+// adjacent ranks with equal weights.
+func collectRuns(high, low Rank, weightOf func(Rank) (float64, bool)) []rankRun {
+	var runs []rankRun
+	for r := high; r >= low; r-- {
+		w, ok := weightOf(r)
+		if !ok {
+			continue
+		}
+		if n := len(runs); n > 0 && runs[n-1].bottom == r+1 && runs[n-1].weight == w {
+			runs[n-1].bottom = r
+			continue
+		}
+		runs = append(runs, rankRun{top: r, bottom: r, weight: w})
+	}
+	return runs
+}
+
+// String formats the range in compact notation, using plus and dash ranges
+// where whole classes are present with equal weights, and listing specific
+// combos otherwise. ParseRange(r.String()) yields the same combinations.
+func (r *Range) String() string {
+	// Split combos into complete classes with a single weight and leftovers
+	byClass := make(map[handClass][]Combo)
+	for _, c := range r.combos {
+		class := comboClass(c)
+		byClass[class] = append(byClass[class], c)
+	}
+
+	full := make(map[handClass]float64)
+	var partial []Combo
+	for class, combos := range byClass {
+		uniform := len(combos) == class.size()
+		for _, c := range combos {
+			uniform = uniform && c.Weight == combos[0].Weight
+		}
+		if uniform {
+			full[class] = combos[0].Weight
+		} else {
+			partial = append(partial, combos...)
+		}
+	}
+
+	// Merge complete suited and offsuit classes with equal weight into "AK"
+	for class, w := range full {
+		if class.kind != suitedClass {
+			continue
+		}
+		offsuit := handClass{high: class.high, low: class.low, kind: offsuitClass}
+		if ow, ok := full[offsuit]; ok && ow == w {
+			delete(full, class)
+			delete(full, offsuit)
+			full[handClass{high: class.high, low: class.low, kind: anyClass}] = w
+		}
+	}
+
+	var tokens []string
+
+	// Pairs: "TT+", "99-66" or "55"
+	pairRuns := collectRuns(Ace, Two, func(rank Rank) (float64, bool) {
+		w, ok := full[handClass{high: rank, low: rank, kind: pairClass}]
+		return w, ok
+	})
+	for _, run := range pairRuns {
+		top := handClass{high: run.top, low: run.top, kind: pairClass}
+		bottom := handClass{high: run.bottom, low: run.bottom, kind: pairClass}
+		switch {
+		case run.top == Ace && run.bottom != Ace:
+			tokens = append(tokens, bottom.String()+"+"+formatWeight(run.weight))
+		case run.top != run.bottom:
+			tokens = append(tokens, top.String()+"-"+bottom.String()+formatWeight(run.weight))
+		default:
+			tokens = append(tokens, top.String()+formatWeight(run.weight))
+		}
+	}
+
+	// Non-pairs by high card: "ATs+", "A5s-A2s" or "K9o"
+	for high := Ace; high > Two; high-- {
+		for _, kind := range []classKind{anyClass, suitedClass, offsuitClass} {
+			runs := collectRuns(high-1, Two, func(low Rank) (float64, bool) {
+				w, ok := full[handClass{high: high, low: low, kind: kind}]
+				return w, ok
+			})
+			for _, run := range runs {
+				top := handClass{high: high, low: run.top, kind: kind}
+				bottom := handClass{high: high, low: run.bottom, kind: kind}
+				switch {
+				case run.top == high-1 && run.bottom != run.top:
+					tokens = append(tokens, bottom.String()+"+"+formatWeight(run.weight))
+				case run.top != run.bottom:
+					tokens = append(tokens, top.String()+"-"+bottom.String()+formatWeight(run.weight))
+				default:
+					tokens = append(tokens, top.String()+formatWeight(run.weight))
+				}
+			}
+		}
+	}
+
+	// Leftover specific combos, strongest first
+	sort.Slice(partial, func(i, j int) bool {
+		a, b := partial[i].Cards, partial[j].Cards
+		if a[0].Rank != b[0].Rank {
+			return a[0].Rank > b[0].Rank
+		}
+		if a[1].Rank != b[1].Rank {
+			return a[1].Rank > b[1].Rank
+		}
+		if a[0].Suit != b[0].Suit {
+			return a[0].Suit < b[0].Suit
+		}
+		return a[1].Suit < b[1].Suit
+	})
+	for _, c := range partial {
+		tokens = append(tokens, c.String()+formatWeight(c.Weight))
+	}
+
+	return strings.Join(tokens, ",")
+}
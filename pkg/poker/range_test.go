@@ -0,0 +1,232 @@
+package poker
+
+import (
+	"testing"
+)
+
+// TestParseRangeComboCounts verifies how each notation expands.
+func TestParseRangeComboCounts(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"KA", 16},
+		{"TT+", 30},
+		{"22-66", 30},
+		{"66-22", 30},
+		{"ATs+", 16},
+		{"A2s-A5s", 16},
+		{"KQo-K9o", 48},
+		{"AT+", 64},
+		{"AhKh", 1},
+		{"AKs:0.5", 4},
+		{"QQ+, AKs, AhKh", 22},
+		{"AKs,AhKh:0.25", 4},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			r, err := ParseRange(tt.notation)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) returned error: %v", tt.notation, err)
+			}
+			if r.Len() != tt.want {
+				t.Errorf("ParseRange(%q).Len() = %d, want %d", tt.notation, r.Len(), tt.want)
+			}
+		})
+	}
+}
+
+// TestParseRangeCombosAreDistinctAndCanonical verifies that every expanded
+// combo uses two different cards with the higher rank first.
+func TestParseRangeCombosAreDistinctAndCanonical(t *testing.T) {
+	r, err := ParseRange("22+,A2+,K2+,Q2+,J2+,T2+,92+,82+,72+,62+,52+,42+,32")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	// Every starting hand: C(52,2) = 1326 combos
+	if r.Len() != 1326 {
+		t.Errorf("full range has %d combos, want 1326", r.Len())
+	}
+
+	seen := make(map[CardSet]bool)
+	for _, c := range r.Combos() {
+		if c.Cards[0] == c.Cards[1] {
+			t.Errorf("combo %v repeats a card", c)
+		}
+		if c.Cards[0].Rank < c.Cards[1].Rank {
+			t.Errorf("combo %v is not in canonical order", c)
+		}
+		if seen[c.CardSet()] {
+			t.Errorf("combo %v appears twice", c)
+		}
+		seen[c.CardSet()] = true
+	}
+}
+
+// TestParseRangeWeights verifies weights and that later elements override earlier ones.
+func TestParseRangeWeights(t *testing.T) {
+	r, err := ParseRange("AKs:0.5, AhKh:0.25")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	for _, c := range r.Combos() {
+		want := 0.5
+		if c.String() == "AhKh" {
+			want = 0.25
+		}
+		if c.Weight != want {
+			t.Errorf("combo %v weight = %v, want %v", c, c.Weight, want)
+		}
+	}
+}
+
+// TestParseRangeErrors verifies that malformed notation is rejected.
+func TestParseRangeErrors(t *testing.T) {
+	tests := []string{
+		"AAs",
+		"AKx",
+		"A",
+		"AKQs",
+		"ZZ",
+		"AKs-QJs",
+		"22-AKs",
+		"A2s-A5o",
+		"AKs:0",
+		"AKs:1.5",
+		"AKs:abc",
+		"AhAh",
+		"22-33-44",
+	}
+
+	for _, notation := range tests {
+		t.Run(notation, func(t *testing.T) {
+			if _, err := ParseRange(notation); err == nil {
+				t.Errorf("ParseRange(%q) should return an error", notation)
+			}
+		})
+	}
+}
+
+// TestRangeWithout verifies removal of combos that conflict with dead cards.
+func TestRangeWithout(t *testing.T) {
+	r, _ := ParseRange("AA,AKs")
+	dead, _ := NewCardSet(mustParseCards(t, "Ah", "2c"))
+
+	filtered := r.Without(dead)
+
+	// AA loses the 3 combos with Ah, AKs loses AhKh
+	if filtered.Len() != 6 {
+		t.Errorf("Without(Ah) has %d combos, want 6", filtered.Len())
+	}
+	for _, c := range filtered.Combos() {
+		if c.CardSet().Intersect(dead) != 0 {
+			t.Errorf("combo %v conflicts with dead cards", c)
+		}
+	}
+	if r.Len() != 10 {
+		t.Errorf("original range changed to %d combos, want 10", r.Len())
+	}
+}
+
+// TestRangeAdd verifies manual insertion and validation.
+func TestRangeAdd(t *testing.T) {
+	r := NewRange()
+	ah, kh := Card{Rank: Ace, Suit: Hearts}, Card{Rank: King, Suit: Hearts}
+
+	if err := r.Add(kh, ah, 1); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if err := r.Add(ah, kh, 0.5); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if r.Len() != 1 || r.Combos()[0].Weight != 0.5 || r.Combos()[0].String() != "AhKh" {
+		t.Errorf("range = %v, want AhKh:0.5", r.Combos())
+	}
+
+	if err := r.Add(ah, ah, 1); err == nil {
+		t.Error("Add with a repeated card should return an error")
+	}
+	if err := r.Add(ah, kh, 2); err == nil {
+		t.Error("Add with weight 2 should return an error")
+	}
+	if err := r.Add(Card{}, kh, 1); err == nil {
+		t.Error("Add with an invalid card should return an error")
+	}
+}
+
+// TestRangeString verifies compact formatting.
+func TestRangeString(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     string
+	}{
+		{"AA", "AA"},
+		{"TT+", "TT+"},
+		{"22-66", "66-22"},
+		{"KK,QQ,55", "KK-QQ,55"},
+		{"ATs+", "ATs+"},
+		{"AKs", "AKs"},
+		{"A2s-A5s", "A5s-A2s"},
+		{"AKs,AKo", "AK"},
+		{"AKs:0.5,AKo", "AKs:0.5,AKo"},
+		{"QQ+:0.5,JJ", "QQ+:0.5,JJ"},
+		{"AhKh", "AhKh"},
+		{"AKs:0.5,AhKh:0.25", "AhKh:0.25,AdKd:0.5,AcKc:0.5,AsKs:0.5"},
+		{"AA,KK,AKs", "KK+,AKs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			r, err := ParseRange(tt.notation)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) returned error: %v", tt.notation, err)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRangeStringRoundTrip verifies that formatting and parsing again yields
+// the same weighted combinations.
+func TestRangeStringRoundTrip(t *testing.T) {
+	tests := []string{
+		"22+,ATs+,KJo+,QTs:0.5,AhKh:0.25,7c6c",
+		"A2s-A5s:0.75,K9s-KTs,JJ-99,54s",
+		"AK,AQ:0.3,T9s,T9o:0.6",
+	}
+
+	for _, notation := range tests {
+		t.Run(notation, func(t *testing.T) {
+			original, err := ParseRange(notation)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) returned error: %v", notation, err)
+			}
+
+			formatted := original.String()
+			parsed, err := ParseRange(formatted)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) returned error: %v", formatted, err)
+			}
+
+			if parsed.Len() != original.Len() {
+				t.Fatalf("round trip via %q has %d combos, want %d", formatted, parsed.Len(), original.Len())
+			}
+			for _, c := range original.Combos() {
+				i, ok := parsed.index[c.CardSet()]
+				if !ok || parsed.combos[i].Weight != c.Weight {
+					t.Errorf("round trip via %q lost or changed %v:%v", formatted, c, c.Weight)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package poker
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// rangeChunkRunouts is the approximate number of runouts in one unit of
+// parallel work. Chunks are merged in order, so results do not depend on
+// how many workers run.
+const rangeChunkRunouts = 1 << 14
+
+// ComboEquity is the equity of one combo in a player's range.
+type ComboEquity struct {
+	Combo  Combo
+	Equity float64 // Average pot share across the matchups and boards this combo played
+	Weight float64 // Total weight of those runouts; 0 if the combo was never dealt
+}
+
+// RangeEquityResult holds overall equity per range plus a per-combo breakdown.
+// The embedded Players report weighted fractions: each runout counts in
+// proportion to the product of the combo weights involved.
+type RangeEquityResult struct {
+	EquityResult
+	Combos [][]ComboEquity // Per player, in the order of that range's combos after dead-card removal
+}
+
+// rangeSetup is the validated layout for a range equity calculation.
+type rangeSetup struct {
+	combos    [][]Combo // Each range's combos, without those blocked by board or dead cards
+	sets      [][]CardSet
+	cumWeight [][]float64 // Running sums of combo weights, for weighted sampling
+	board     CardSet
+	remaining CardSet // Cards not on the board and not dead
+	need      int
+}
+
+// newRangeSetup validates the ranges, board and dead cards.
+func newRangeSetup(ranges []*Range, board, dead []Card) (*rangeSetup, error) {
+	if len(ranges) < 2 {
+		return nil, fmt.Errorf("equity needs at least 2 players, got %d", len(ranges))
+	}
+	if len(board) > 5 {
+		return nil, fmt.Errorf("board must contain at most 5 cards, got %d", len(board))
+	}
+
+	var tracker cardTracker
+	boardSet, err := tracker.add(board, "board")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tracker.add(dead, "dead cards"); err != nil {
+		return nil, err
+	}
+
+	setup := &rangeSetup{
+		combos:    make([][]Combo, len(ranges)),
+		sets:      make([][]CardSet, len(ranges)),
+		cumWeight: make([][]float64, len(ranges)),
+		board:     boardSet,
+		remaining: FullDeckSet.Difference(tracker.used),
+		need:      5 - len(board),
+	}
+
+	for p, r := range ranges {
+		if r == nil {
+			return nil, fmt.Errorf("player %d: range is nil", p+1)
+		}
+		live := r.Without(tracker.used)
+		if live.Len() == 0 {
+			return nil, fmt.Errorf("player %d: no combos left after removing board and dead cards", p+1)
+		}
+		setup.combos[p] = live.Combos()
+		setup.sets[p] = make([]CardSet, live.Len())
+		setup.cumWeight[p] = make([]float64, live.Len())
+		total := 0.0
+		for i, c := range setup.combos[p] {
+			setup.sets[p][i] = c.CardSet()
+			total += c.Weight
+			setup.cumWeight[p][i] = total
+		}
+	}
+
+	if !setup.hasMatchup() {
+		return nil, fmt.Errorf("ranges have no combination of hands without shared cards")
+	}
+	if setup.remaining.Count()-2*len(ranges) < setup.need {
+		return nil, fmt.Errorf("cannot complete the board: need %d cards, only %d available", setup.need, setup.remaining.Count()-2*len(ranges))
+	}
+	return setup, nil
+}
+
+// matchups calls fn for every assignment of one combo per player where no
+// two combos share a card, with the product of their weights. fn may stop
+// the walk by returning false.
+func (s *rangeSetup) matchups(fn func(picks []int, weight float64) bool) {
+	picks := make([]int, len(s.combos))
+	var walk func(p int, used CardSet, weight float64) bool
+	walk = func(p int, used CardSet, weight float64) bool {
+		if p == len(s.combos) {
+			return fn(picks, weight)
+		}
+		for i, set := range s.sets[p] {
+			if set&used != 0 {
+				continue
+			}
+			picks[p] = i
+			if !walk(p+1, used|set, weight*s.combos[p][i].Weight) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(0, 0, 1)
+}
+
+// hasMatchup reports whether at least one conflict-free matchup exists.
+func (s *rangeSetup) hasMatchup() bool {
+	found := false
+	s.matchups(func([]int, float64) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// maxRunouts is an upper bound on the runouts exact enumeration would visit:
+// the product of range sizes times the number of boards for one matchup.
+func (s *rangeSetup) maxRunouts() float64 {
+	total := float64(binomial(s.remaining.Count()-2*len(s.combos), s.need))
+	for _, combos := range s.combos {
+		total *= float64(len(combos))
+	}
+	return total
+}
+
+// exact reports whether the options call for exhaustive enumeration.
+func (s *rangeSetup) exact(opts EquityOptions) bool {
+	switch opts.Mode {
+	case EquityExact:
+		return true
+	case EquityMonteCarlo:
+		return false
+	}
+	threshold := opts.ExactThreshold
+	if threshold <= 0 {
+		threshold = DefaultExactThreshold
+	}
+	return s.maxRunouts() <= float64(threshold)
+}
+
+// rangeTally accumulates overall and per-combo results for one chunk of work.
+type rangeTally struct {
+	*equityTally
+	comboShare  [][]float64
+	comboWeight [][]float64
+	ranks       []HandRank
+	shares      []float64
+}
+
+// newRangeTally creates an empty tally sized for the setup.
+func newRangeTally(s *rangeSetup) *rangeTally {
+	t := &rangeTally{
+		equityTally: newEquityTally(len(s.combos)),
+		comboShare:  make([][]float64, len(s.combos)),
+		comboWeight: make([][]float64, len(s.combos)),
+		ranks:       make([]HandRank, len(s.combos)),
+		shares:      make([]float64, len(s.combos)),
+	}
+	for p, combos := range s.combos {
+		t.comboShare[p] = make([]float64, len(combos))
+		t.comboWeight[p] = make([]float64, len(combos))
+	}
+	return t
+}
+
+// play evaluates one complete board for a matchup and records the outcome.
+func (t *rangeTally) play(s *rangeSetup, picks []int, board CardSet, weight float64) {
+	for p, i := range picks {
+		t.ranks[p] = EvaluateCardSet(s.sets[p][i] | board)
+	}
+	splitShares(t.ranks, t.shares)
+	t.record(t.shares, weight)
+	for p, i := range picks {
+		t.comboShare[p][i] += weight * t.shares[p]
+		t.comboWeight[p][i] += weight
+	}
+}
+
+// merge adds another tally's results into t.
+func (t *rangeTally) merge(other *rangeTally) {
+	t.equityTally.merge(other.equityTally)
+	for p := range t.comboShare {
+		for i := range t.comboShare[p] {
+			t.comboShare[p][i] += other.comboShare[p][i]
+			t.comboWeight[p][i] += other.comboWeight[p][i]
+		}
+	}
+}
+
+// result converts the tally to a RangeEquityResult.
+func (t *rangeTally) result(s *rangeSetup, exact bool) *RangeEquityResult {
+	res := &RangeEquityResult{
+		EquityResult: *t.equityTally.result(exact),
+		Combos:       make([][]ComboEquity, len(s.combos)),
+	}
+	for p, combos := range s.combos {
+		res.Combos[p] = make([]ComboEquity, len(combos))
+		for i, c := range combos {
+			ce := ComboEquity{Combo: c, Weight: t.comboWeight[p][i]}
+			if ce.Weight > 0 {
+				ce.Equity = t.comboShare[p][i] / ce.Weight
+			}
+			res.Combos[p][i] = ce
+		}
+	}
+	return res
+}
+
+// rangeJob is one unit of parallel work: either a batch of matchups to
+// enumerate exactly, or a number of random runouts to sample.
+type rangeJob struct {
+	index    int
+	matchups [][]int
+	weights  []float64
+	samples  int
+	seed     uint64
+}
+
+// runExact enumerates every board for each matchup in the job.
+func (s *rangeSetup) runExact(ctx context.Context, job rangeJob, t *rangeTally) {
+	for m, picks := range job.matchups {
+		if ctx.Err() != nil {
+			return
+		}
+		var holes CardSet
+		for p, i := range picks {
+			holes |= s.sets[p][i]
+		}
+		for runout := range CombinationsOfSet(s.remaining&^holes, s.need) {
+			t.play(s, picks, s.board|runout, job.weights[m])
+		}
+	}
+}
+
+// pickCombo draws one combo index for player p with probability
+// proportional to its weight.
+func (s *rangeSetup) pickCombo(p int, src rand.Source) int {
+	cum := s.cumWeight[p]
+	u := float64(src.Uint64()>>11) / (1 << 53) * cum[len(cum)-1]
+	i := sort.SearchFloat64s(cum, u)
+	if i < len(cum) && cum[i] == u {
+		i++
+	}
+	return min(i, len(cum)-1)
+}
+
+// runSamples draws random matchups (by weight) and random boards.
+func (s *rangeSetup) runSamples(ctx context.Context, job rangeJob, t *rangeTally) {
+	src := rand.NewPCG(job.seed, uint64(job.index))
+	draws := newSampler(s.remaining, src)
+	picks := make([]int, len(s.combos))
+
+	for n := 0; n < job.samples; n++ {
+		if n%cancelCheckInterval == 0 && ctx.Err() != nil {
+			return
+		}
+
+		// Rejection sampling keeps matchups proportional to their weight
+		// product; heavily overlapping ranges can take many tries
+		var holes CardSet
+		for tries := 1; ; tries++ {
+			if tries%cancelCheckInterval == 0 && ctx.Err() != nil {
+				return
+			}
+			holes = 0
+			conflict := false
+			for p := range picks {
+				picks[p] = s.pickCombo(p, src)
+				set := s.sets[p][picks[p]]
+				conflict = conflict || holes&set != 0
+				holes |= set
+			}
+			if !conflict {
+				break
+			}
+		}
+
+		// Redraw boards that hit a hole card; the accepted board is uniform
+		// over the cards that are actually left
+		runout := draws.draw(s.need)
+		for runout&holes != 0 {
+			runout = draws.draw(s.need)
+		}
+		t.play(s, picks, s.board|runout, 1)
+	}
+}
+
+// CalculateRangeEquity computes the equity of two or more ranges against
+// each other on the given board, respecting combo weights and card removal
+// between players. Like CalculateEquity it enumerates exactly or samples
+// according to opts.Mode; EquityAuto enumerates when the product of range
+// sizes times the number of boards is at most opts.ExactThreshold.
+//
+// Work is split into chunks evaluated on all available cores and merged in a
+// fixed order, so sampled results are reproducible for a fixed opts.Seed.
+// If ctx is cancelled, the chunks completed so far are returned together
+// with ctx.Err().
+func CalculateRangeEquity(ctx context.Context, ranges []*Range, opts EquityOptions) (*RangeEquityResult, error) {
+	setup, err := newRangeSetup(ranges, opts.Board, opts.Dead)
+	if err != nil {
+		return nil, err
+	}
+	exact := setup.exact(opts)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan rangeJob)
+	go func() {
+		defer close(jobs)
+		send := func(job rangeJob) bool {
+			select {
+			case jobs <- job:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if exact {
+			boards := binomial(setup.remaining.Count()-2*len(ranges), setup.need)
+			batch := int(max(1, rangeChunkRunouts/boards))
+			job := rangeJob{}
+			setup.matchups(func(picks []int, weight float64) bool {
+				job.matchups = append(job.matchups, append([]int(nil), picks...))
+				job.weights = append(job.weights, weight)
+				if len(job.matchups) < batch {
+					return true
+				}
+				ok := send(job)
+				job = rangeJob{index: job.index + 1}
+				return ok
+			})
+			if len(job.matchups) > 0 {
+				send(job)
+			}
+			return
+		}
+
+		iterations := opts.Iterations
+		if iterations <= 0 {
+			iterations = DefaultEquityIterations
+		}
+		seed := opts.Seed
+		if seed == 0 {
+			seed = CryptoSource{}.Uint64()
+		}
+		for index, done := 0, 0; done < iterations; index++ {
+			n := min(rangeChunkRunouts, iterations-done)
+			if !send(rangeJob{index: index, samples: n, seed: seed}) {
+				return
+			}
+			done += n
+		}
+	}()
+
+	type chunkResult struct {
+		index int
+		tally *rangeTally
+	}
+	results := make(chan chunkResult)
+
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				tally := newRangeTally(setup)
+				if exact {
+					setup.runExact(ctx, job, tally)
+				} else {
+					setup.runSamples(ctx, job, tally)
+				}
+				results <- chunkResult{index: job.index, tally: tally}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Merge chunks strictly in index order so floating-point sums are reproducible
+	total := newRangeTally(setup)
+	pending := make(map[int]*rangeTally)
+	next := 0
+	for r := range results {
+		pending[r.index] = r.tally
+		for t, ok := pending[next]; ok; t, ok = pending[next] {
+			total.merge(t)
+			delete(pending, next)
+			next++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return total.result(setup, false), err
+	}
+	return total.result(setup, exact), nil
+}
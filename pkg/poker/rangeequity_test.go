@@ -0,0 +1,270 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// mustParseRange parses range notation or fails the test.
+func mustParseRange(t testing.TB, notation string) *Range {
+	t.Helper()
+	r, err := ParseRange(notation)
+	if err != nil {
+		t.Fatalf("ParseRange(%q) returned error: %v", notation, err)
+	}
+	return r
+}
+
+// TestCalculateRangeEquitySingleCombosMatchHandEquity verifies that ranges of
+// one combo each reproduce CalculateEquity exactly.
+func TestCalculateRangeEquitySingleCombosMatchHandEquity(t *testing.T) {
+	board := mustParseCards(t, "Kh", "7d", "2c")
+	opts := EquityOptions{Board: board, Mode: EquityExact}
+
+	want, err := CalculateEquity(context.Background(), [][]Card{
+		mustParseCards(t, "Ah", "Ad"),
+		mustParseCards(t, "Ks", "Qs"),
+	}, opts)
+	if err != nil {
+		t.Fatalf("CalculateEquity returned error: %v", err)
+	}
+
+	got, err := CalculateRangeEquity(context.Background(), []*Range{
+		mustParseRange(t, "AhAd"),
+		mustParseRange(t, "KsQs"),
+	}, opts)
+	if err != nil {
+		t.Fatalf("CalculateRangeEquity returned error: %v", err)
+	}
+
+	if !got.Exact || got.Runouts != want.Runouts {
+		t.Errorf("Runouts = %d, Exact = %v, want %d exact runouts", got.Runouts, got.Exact, want.Runouts)
+	}
+	for i := range want.Players {
+		if math.Abs(got.Players[i].Equity-want.Players[i].Equity) > 1e-12 {
+			t.Errorf("player %d equity = %.6f, want %.6f", i+1, got.Players[i].Equity, want.Players[i].Equity)
+		}
+		if got.Players[i].Wins != want.Players[i].Wins || got.Players[i].Ties != want.Players[i].Ties {
+			t.Errorf("player %d wins/ties = %d/%d, want %d/%d", i+1,
+				got.Players[i].Wins, got.Players[i].Ties, want.Players[i].Wins, want.Players[i].Ties)
+		}
+	}
+}
+
+// TestCalculateRangeEquityExactMatchesAveragedMatchups verifies exact range
+// equity against a weighted average of the individual hand matchups.
+func TestCalculateRangeEquityExactMatchesAveragedMatchups(t *testing.T) {
+	board := mustParseCards(t, "Ah", "Td", "5c", "2s")
+	hero := mustParseRange(t, "KK:0.5,AKs")
+	villain := mustParseRange(t, "AT,55")
+
+	got, err := CalculateRangeEquity(context.Background(), []*Range{hero, villain}, EquityOptions{Board: board})
+	if err != nil {
+		t.Fatalf("CalculateRangeEquity returned error: %v", err)
+	}
+	if !got.Exact {
+		t.Fatal("small ranges on the turn should be enumerated exactly")
+	}
+
+	dead, _ := NewCardSet(board)
+	var total, share float64
+	for _, h := range hero.Without(dead).Combos() {
+		for _, v := range villain.Without(dead).Combos() {
+			if h.CardSet().Intersect(v.CardSet()) != 0 {
+				continue
+			}
+			res, err := CalculateEquity(context.Background(), [][]Card{h.Cards[:], v.Cards[:]}, EquityOptions{Board: board})
+			if err != nil {
+				t.Fatalf("CalculateEquity(%v vs %v) returned error: %v", h, v, err)
+			}
+			// Every matchup has the same number of rivers, so weight by combos only
+			w := h.Weight * v.Weight
+			total += w
+			share += w * res.Players[0].Equity
+		}
+	}
+
+	if want := share / total; math.Abs(got.Players[0].Equity-want) > 1e-9 {
+		t.Errorf("hero equity = %.6f, want %.6f", got.Players[0].Equity, want)
+	}
+	if math.Abs(got.Players[0].Equity+got.Players[1].Equity-1) > 1e-9 {
+		t.Errorf("equities sum to %.6f, want 1", got.Players[0].Equity+got.Players[1].Equity)
+	}
+}
+
+// TestCalculateRangeEquityComboBreakdown verifies per-combo equities and
+// that combos blocked by the board are left out.
+func TestCalculateRangeEquityComboBreakdown(t *testing.T) {
+	board := mustParseCards(t, "As", "Kd", "7c", "7h", "2s")
+	got, err := CalculateRangeEquity(context.Background(), []*Range{
+		mustParseRange(t, "AA,22"),
+		mustParseRange(t, "KK"),
+	}, EquityOptions{Board: board})
+	if err != nil {
+		t.Fatalf("CalculateRangeEquity returned error: %v", err)
+	}
+
+	// AA loses As and 22 loses 2s, leaving 3 + 3 combos
+	if len(got.Combos[0]) != 6 || len(got.Combos[1]) != 3 {
+		t.Fatalf("combo counts = %d, %d, want 6, 3", len(got.Combos[0]), len(got.Combos[1]))
+	}
+
+	for _, ce := range got.Combos[0] {
+		// Aces full beat kings full; 2s full of 7s lose to kings full
+		want := 0.0
+		if ce.Combo.Cards[0].Rank == Ace {
+			want = 1
+		}
+		if ce.Equity != want {
+			t.Errorf("%v equity = %v, want %v", ce.Combo, ce.Equity, want)
+		}
+		if ce.Weight <= 0 {
+			t.Errorf("%v weight = %v, want positive", ce.Combo, ce.Weight)
+		}
+	}
+	if math.Abs(got.Players[0].Equity-0.5) > 1e-12 {
+		t.Errorf("hero equity = %.6f, want 0.5", got.Players[0].Equity)
+	}
+}
+
+// TestCalculateRangeEquityWeights verifies that combo weights shift equity.
+func TestCalculateRangeEquityWeights(t *testing.T) {
+	board := mustParseCards(t, "As", "Kd", "7c", "7h", "2s")
+	tests := []struct {
+		notation string
+		want     float64
+	}{
+		{"AA,22", 0.5},
+		{"AA,22:0.5", 2.0 / 3},
+		{"AA:0.25,22", 0.2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			got, err := CalculateRangeEquity(context.Background(), []*Range{
+				mustParseRange(t, tt.notation),
+				mustParseRange(t, "KK"),
+			}, EquityOptions{Board: board})
+			if err != nil {
+				t.Fatalf("CalculateRangeEquity returned error: %v", err)
+			}
+			if math.Abs(got.Players[0].Equity-tt.want) > 1e-12 {
+				t.Errorf("equity = %.6f, want %.6f", got.Players[0].Equity, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculateRangeEquityCardRemoval verifies that combos sharing a card are
+// never dealt together.
+func TestCalculateRangeEquityCardRemoval(t *testing.T) {
+	// Villain holds AhAd or AhKh; hero's AhKh can only face AhAd if removal
+	// is ignored, so with removal hero's AhKh is never dealt
+	got, err := CalculateRangeEquity(context.Background(), []*Range{
+		mustParseRange(t, "AhKh,QQ"),
+		mustParseRange(t, "AhAd"),
+	}, EquityOptions{Board: mustParseCards(t, "2c", "3c", "8d")})
+	if err != nil {
+		t.Fatalf("CalculateRangeEquity returned error: %v", err)
+	}
+
+	for _, ce := range got.Combos[0] {
+		dealt := ce.Weight > 0
+		if ce.Combo.String() == "AhKh" && dealt {
+			t.Errorf("AhKh was dealt against AhAd")
+		}
+		if ce.Combo.String() != "AhKh" && !dealt {
+			t.Errorf("%v was never dealt", ce.Combo)
+		}
+	}
+}
+
+// TestCalculateRangeEquityMonteCarlo verifies sampled range equity against
+// the exact result and checks that a seed reproduces it on any core count.
+func TestCalculateRangeEquityMonteCarlo(t *testing.T) {
+	ranges := []*Range{
+		mustParseRange(t, "TT+,AKs"),
+		mustParseRange(t, "AQs+,KQs,JJ:0.5"),
+	}
+	board := mustParseCards(t, "Qh", "8s", "3d")
+
+	exact, err := CalculateRangeEquity(context.Background(), ranges, EquityOptions{Board: board, Mode: EquityExact})
+	if err != nil {
+		t.Fatalf("exact CalculateRangeEquity returned error: %v", err)
+	}
+
+	opts := EquityOptions{Board: board, Mode: EquityMonteCarlo, Iterations: 60000, Seed: 7}
+	sampled, err := CalculateRangeEquity(context.Background(), ranges, opts)
+	if err != nil {
+		t.Fatalf("sampled CalculateRangeEquity returned error: %v", err)
+	}
+
+	if sampled.Exact || sampled.Runouts != 60000 {
+		t.Errorf("Runouts = %d, Exact = %v, want 60000 sampled runouts", sampled.Runouts, sampled.Exact)
+	}
+	diff := math.Abs(sampled.Players[0].Equity - exact.Players[0].Equity)
+	if diff > 4*sampled.Players[0].StdErr+1e-3 {
+		t.Errorf("sampled equity %.4f (±%.4f) is far from exact %.4f",
+			sampled.Players[0].Equity, sampled.Players[0].StdErr, exact.Players[0].Equity)
+	}
+
+	again, err := CalculateRangeEquity(context.Background(), ranges, opts)
+	if err != nil {
+		t.Fatalf("repeated CalculateRangeEquity returned error: %v", err)
+	}
+	if !reflect.DeepEqual(sampled, again) {
+		t.Error("the same seed produced different results")
+	}
+}
+
+// TestCalculateRangeEquityCancelled verifies that a cancelled context
+// returns the error with a partial result.
+func TestCalculateRangeEquityCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := CalculateRangeEquity(ctx, []*Range{
+		mustParseRange(t, "22+"),
+		mustParseRange(t, "AK"),
+	}, EquityOptions{Mode: EquityMonteCarlo, Iterations: 1000000})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if result == nil || result.Runouts >= 1000000 {
+		t.Errorf("cancelled calculation should return a partial result, got %+v", result)
+	}
+}
+
+// TestCalculateRangeEquityInvalidInput verifies argument validation.
+func TestCalculateRangeEquityInvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []string
+		board  []string
+		dead   []string
+	}{
+		{"one range", []string{"AA"}, nil, nil},
+		{"empty range", []string{"AA", ""}, nil, nil},
+		{"range blocked by board", []string{"AhKh", "QQ"}, []string{"Ah", "2c", "3d"}, nil},
+		{"range blocked by dead cards", []string{"AA", "KhQh"}, nil, []string{"Qh"}},
+		{"no compatible matchup", []string{"AhAd", "AhAd"}, nil, nil},
+		{"board too long", []string{"AA", "KK"}, []string{"2c", "3c", "4c", "5c", "6c", "7c"}, nil},
+		{"dead card on board", []string{"AA", "KK"}, []string{"2c", "3c", "4c"}, []string{"2c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranges := make([]*Range, len(tt.ranges))
+			for i, notation := range tt.ranges {
+				ranges[i] = mustParseRange(t, notation)
+			}
+			opts := EquityOptions{Board: mustParseCards(t, tt.board...), Dead: mustParseCards(t, tt.dead...)}
+			if _, err := CalculateRangeEquity(context.Background(), ranges, opts); err == nil {
+				t.Error("CalculateRangeEquity should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package poker
+
+import "sort"
+
+// evaluateAceToFive evaluates exactly 5 cards as an ace-to-five low with no
+// qualifier: aces are low, straights and flushes are ignored, and pairs
+// count against the hand. Cards are ordered by importance, so a paired hand
+// lists its pair first (for example 9-9-5-3-2).
+func evaluateAceToFive(cards []Card) *LowHand {
+	var counts [Ace + 1]int
+	for _, card := range cards {
+		counts[lowValue(card.Rank)]++
+	}
+
+	ordered := make([]Card, len(cards))
+	copy(ordered, cards)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		vi, vj := lowValue(ordered[i].Rank), lowValue(ordered[j].Rank)
+		if counts[vi] != counts[vj] {
+			return counts[vi] > counts[vj]
+		}
+		return vi > vj
+	})
+
+	low := &LowHand{Cards: ordered, Category: HighCard}
+	var groups []int
+	for i, card := range ordered {
+		if i == 0 || card.Rank != ordered[i-1].Rank {
+			low.Tiebreakers = append(low.Tiebreakers, card.Rank)
+			groups = append(groups, counts[lowValue(card.Rank)])
+		}
+	}
+
+	switch {
+	case groups[0] == 4:
+		low.Category = FourOfAKind
+	case groups[0] == 3 && groups[1] == 2:
+		low.Category = FullHouse
+	case groups[0] == 3:
+		low.Category = ThreeOfAKind
+	case groups[0] == 2 && groups[1] == 2:
+		low.Category = TwoPair
+	case groups[0] == 2:
+		low.Category = OnePair
+	}
+	return low
+}
+
+// FindBestRazzHand finds the best Razz hand from 5 or more cards, typically
+// a player's 7 stud cards. Razz is ace-to-five low with no qualifier: aces
+// are low, straights and flushes do not count, and pairs count against the
+// hand. Compare results with CompareLowHands; the best hand is 5-4-3-2-A.
+// Returns nil if fewer than 5 cards are provided or any card is invalid or
+// repeated.
+func FindBestRazzHand(cards []Card) *LowHand {
+	if len(cards) < 5 {
+		return nil
+	}
+	if _, err := NewCardSet(cards); err != nil {
+		return nil
+	}
+
+	// Five distinct ranks always make the best hand
+	if low := unpairedLow(cards, King); low != nil {
+		return low
+	}
+
+	var best *LowHand
+	for _, combo := range Combinations(cards, 5) {
+		if low := evaluateAceToFive(combo); CompareLowHands(low, best) > 0 {
+			best = low
+		}
+	}
+	return best
+}
@@ -0,0 +1,96 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFindBestRazzHand verifies best-low selection from seven stud cards.
+func TestFindBestRazzHand(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []string
+		want     string
+		category HandCategory
+	}{
+		{"wheel", []string{"Ah", "2d", "3c", "4s", "5h", "Kd", "Kc"}, "5-4-3-2-A", HighCard},
+		{"seven low", []string{"7h", "5d", "4c", "3s", "2h", "Qd", "7c"}, "7-5-4-3-2", HighCard},
+		{"straight and flush do not count", []string{"6h", "5h", "4h", "3h", "2h", "Jd", "Qc"}, "6-5-4-3-2", HighCard},
+		{"no qualifier", []string{"Kh", "Qd", "Jc", "Ts", "9h", "Kd", "Qc"}, "K-Q-J-T-9", HighCard},
+		{"forced pair", []string{"9h", "9d", "5c", "5s", "3h", "3d", "2c"}, "3-3-9-5-2", OnePair},
+		{"forced two pair", []string{"8h", "8d", "4c", "4s", "2h", "2d", "8c"}, "4-4-2-2-8", TwoPair},
+		{"forced full house", []string{"Kh", "Kd", "Kc", "7s", "7h", "7d", "Ks"}, "7-7-7-K-K", FullHouse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := FindBestRazzHand(mustParseCards(t, tt.cards...))
+			if hand == nil {
+				t.Fatal("FindBestRazzHand returned nil")
+			}
+			if got := hand.String(); got != tt.want {
+				t.Errorf("FindBestRazzHand = %s, want %s", got, tt.want)
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+		})
+	}
+
+	if hand := FindBestRazzHand(mustParseCards(t, "Ah", "2d", "3c", "4s")); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+	if hand := FindBestRazzHand(mustParseCards(t, "Ah", "Ah", "2d", "3c", "4s")); hand != nil {
+		t.Errorf("repeated card returned %v, want nil", hand)
+	}
+}
+
+// TestCompareRazzHands verifies that pairs rank below any unpaired low.
+func TestCompareRazzHands(t *testing.T) {
+	razz := func(cards ...string) *LowHand { return FindBestRazzHand(mustParseCards(t, cards...)) }
+
+	tests := []struct {
+		name     string
+		low1     *LowHand
+		low2     *LowHand
+		expected int
+	}{
+		{"king high beats a pair of aces", razz("Kh", "Qd", "Jc", "Ts", "8h"), razz("Ah", "Ad", "2c", "3s", "4h"), 1},
+		{"pair of aces beats pair of deuces", razz("Ah", "Ad", "5c", "6s", "7h"), razz("2h", "2d", "3c", "4s", "5h"), 1},
+		{"smaller kicker wins with the same pair", razz("4h", "4d", "7c", "3s", "2h"), razz("4c", "4s", "8c", "3d", "2d"), 1},
+		{"one pair beats two pair", razz("Kh", "Kd", "Qc", "Js", "Th"), razz("2h", "2d", "3c", "3s", "4h"), 1},
+		{"eight-six beats eight-seven", razz("8h", "6d", "5c", "4s", "3h"), razz("8d", "7c", "3s", "2h", "Ah"), 1},
+		{"same ranks tie", razz("7h", "5d", "4c", "3s", "2h"), razz("7d", "5c", "4h", "3d", "2s"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareLowHands(tt.low1, tt.low2); got != tt.expected {
+				t.Errorf("CompareLowHands(%v, %v) = %d, want %d", tt.low1, tt.low2, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFindBestRazzHandMatchesBruteForce verifies random seven-card deals
+// against evaluating every 5-card combination.
+func TestFindBestRazzHandMatchesBruteForce(t *testing.T) {
+	src := NewSeededSource(12)
+	for deal := 0; deal < 500; deal++ {
+		deck := NewDeck()
+		deck.Shuffle(src)
+		cards, _ := deck.Deal(7)
+
+		var want *LowHand
+		for _, combo := range Combinations(cards, 5) {
+			if low := evaluateAceToFive(combo); CompareLowHands(low, want) > 0 {
+				want = low
+			}
+		}
+
+		got := FindBestRazzHand(cards)
+		if CompareLowHands(got, want) != 0 || !reflect.DeepEqual(got.Tiebreakers, want.Tiebreakers) {
+			t.Fatalf("cards %v: got %v, want %v", cards, got, want)
+		}
+	}
+}
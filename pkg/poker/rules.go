@@ -0,0 +1,131 @@
+package poker
+
+// RankingRules describes how a poker variant ranks hand categories and
+// which ace-low straight it recognizes. A nil *RankingRules means the
+// standard rules.
+type RankingRules struct {
+	Name                string
+	FlushBeatsFullHouse bool // Flush ranks above full house, as in Short Deck
+	TripsBeatStraight   bool // Three of a kind ranks above straight, as in some Short Deck rule sets
+	LowStraightHigh     Rank // Top card of the ace-low straight: Five for A-2-3-4-5 (the default), Nine for A-6-7-8-9
+}
+
+// Predefined rule sets.
+var (
+	// StandardRules are the usual high-hand rankings used by Hold'em and Omaha.
+	StandardRules = &RankingRules{Name: "Standard", LowStraightHigh: Five}
+
+	// ShortDeckRules rank a flush above a full house and count A-6-7-8-9
+	// as the lowest straight.
+	ShortDeckRules = &RankingRules{Name: "Short Deck", FlushBeatsFullHouse: true, LowStraightHigh: Nine}
+
+	// ShortDeckTripsRules additionally rank three of a kind above a straight.
+	ShortDeckTripsRules = &RankingRules{Name: "Short Deck (trips beat straight)", FlushBeatsFullHouse: true, TripsBeatStraight: true, LowStraightHigh: Nine}
+)
+
+// strength returns the relative strength of a category under the rules.
+// Swapped categories trade places; all others keep their standard order.
+func (r *RankingRules) strength(c HandCategory) int {
+	if r == nil {
+		return int(c)
+	}
+	switch {
+	case r.FlushBeatsFullHouse && c == Flush:
+		return int(FullHouse)
+	case r.FlushBeatsFullHouse && c == FullHouse:
+		return int(Flush)
+	case r.TripsBeatStraight && c == ThreeOfAKind:
+		return int(Straight)
+	case r.TripsBeatStraight && c == Straight:
+		return int(ThreeOfAKind)
+	}
+	return int(c)
+}
+
+// lowStraightHigh returns the top card of the rules' ace-low straight.
+func (r *RankingRules) lowStraightHigh() Rank {
+	if r == nil || r.LowStraightHigh == 0 {
+		return Five
+	}
+	return r.LowStraightHigh
+}
+
+// isStandard reports whether the rules rank hands exactly like EvaluateHand.
+func (r *RankingRules) isStandard() bool {
+	return r == nil || (!r.FlushBeatsFullHouse && !r.TripsBeatStraight && r.lowStraightHigh() == Five)
+}
+
+// isAceLowStraight reports whether 5 cards are an ace followed by the four
+// ranks up to and including high, such as A-6-7-8-9 for high = Nine.
+func isAceLowStraight(cards []Card, high Rank) bool {
+	var seen [Ace + 1]bool
+	for _, card := range cards {
+		ok := card.Rank == Ace || (card.Rank <= high && card.Rank > high-4)
+		if !ok || seen[card.Rank] {
+			return false
+		}
+		seen[card.Rank] = true
+	}
+	return true
+}
+
+// EvaluateHandWithRules evaluates exactly 5 cards under the given ranking
+// rules. Detection follows EvaluateHand except for the ace-low straight:
+// under ShortDeckRules A-6-7-8-9 is a nine-high straight and A-2-3-4-5 is
+// not a straight. The returned Hand carries the rules, so CompareHands
+// orders it correctly. A nil rules value means StandardRules.
+// Returns nil if the input is not exactly 5 cards.
+func EvaluateHandWithRules(cards []Card, rules *RankingRules) *Hand {
+	if len(cards) != 5 {
+		return nil
+	}
+	if rules == nil {
+		rules = StandardRules
+	}
+
+	hand := EvaluateHand(cards)
+	hand.Rules = rules
+	if low := rules.lowStraightHigh(); low != Five {
+		switch {
+		case isWheel(hand):
+			playWheelAceHigh(hand, cards)
+		case isAceLowStraight(cards, low):
+			hand.Tiebreakers = []Rank{low}
+			if isFlush(cards) {
+				hand.Category = StraightFlush
+			} else {
+				hand.Category = Straight
+			}
+		}
+	}
+	return hand
+}
+
+// FindBestHandWithRules finds the best 5-card hand from 5 or more cards
+// under the given ranking rules. Standard rules use FindBestHand; other
+// rules evaluate every 5-card combination with EvaluateHandWithRules.
+// Returns nil if fewer than 5 cards are provided.
+func FindBestHandWithRules(cards []Card, rules *RankingRules) *Hand {
+	if len(cards) < 5 {
+		return nil
+	}
+	if rules == nil {
+		rules = StandardRules
+	}
+
+	if rules.isStandard() {
+		hand := FindBestHand(cards)
+		if hand != nil {
+			hand.Rules = rules
+		}
+		return hand
+	}
+
+	var best *Hand
+	for _, combo := range Combinations(cards, 5) {
+		if hand := EvaluateHandWithRules(combo, rules); best == nil || CompareHands(hand, best) > 0 {
+			best = hand
+		}
+	}
+	return best
+}
@@ -0,0 +1,128 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEvaluateHandWithRulesShortDeckStraights verifies the A-6-7-8-9 straight
+// and that A-2-3-4-5 is not a straight under Short Deck rules.
+func TestEvaluateHandWithRulesShortDeckStraights(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		rules       *RankingRules
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"A-6-7-8-9 is a straight", []string{"Ah", "6d", "7c", "8s", "9h"}, ShortDeckRules, Straight, []Rank{Nine}},
+		{"suited A-6-7-8-9 is a straight flush", []string{"As", "6s", "7s", "8s", "9s"}, ShortDeckRules, StraightFlush, []Rank{Nine}},
+		{"A-6-7-8-9 is not a straight in standard rules", []string{"Ah", "6d", "7c", "8s", "9h"}, StandardRules, HighCard, []Rank{Ace, Nine, Eight, Seven, Six}},
+		{"wheel is not a Short Deck straight", []string{"Ah", "2d", "3c", "4s", "5h"}, ShortDeckRules, HighCard, []Rank{Ace, Five, Four, Three, Two}},
+		{"wheel is a standard straight", []string{"Ah", "2d", "3c", "4s", "5h"}, nil, Straight, []Rank{Five}},
+		{"six to ten is unchanged", []string{"6h", "7d", "8c", "9s", "Th"}, ShortDeckRules, Straight, []Rank{Ten}},
+		{"royal flush is unchanged", []string{"Ah", "Kh", "Qh", "Jh", "Th"}, ShortDeckRules, RoyalFlush, []Rank{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := EvaluateHandWithRules(mustParseCards(t, tt.cards...), tt.rules)
+			if hand == nil {
+				t.Fatal("EvaluateHandWithRules returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if !reflect.DeepEqual(hand.Tiebreakers, tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreakers)
+			}
+			if hand.Rules == nil {
+				t.Error("Rules should be set on the returned hand")
+			}
+		})
+	}
+
+	if hand := EvaluateHandWithRules(mustParseCards(t, "Ah", "6d", "7c", "8s"), ShortDeckRules); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+}
+
+// TestCompareHandsWithRules verifies category order under each rule set.
+func TestCompareHandsWithRules(t *testing.T) {
+	flush := mustParseCards(t, "Ah", "Jh", "9h", "7h", "6h")
+	fullHouse := mustParseCards(t, "Kh", "Kd", "Kc", "6s", "6d")
+	trips := mustParseCards(t, "Qh", "Qd", "Qc", "8s", "6d")
+	straight := mustParseCards(t, "Ah", "6d", "7c", "8s", "9h")
+	higherStraight := mustParseCards(t, "6h", "7d", "8c", "9s", "Th")
+
+	tests := []struct {
+		name     string
+		rules    *RankingRules
+		hand1    []Card
+		hand2    []Card
+		expected int
+	}{
+		{"standard full house beats flush", StandardRules, fullHouse, flush, 1},
+		{"Short Deck flush beats full house", ShortDeckRules, flush, fullHouse, 1},
+		{"Short Deck straight beats trips", ShortDeckRules, straight, trips, 1},
+		{"trips rule set ranks trips above straight", ShortDeckTripsRules, trips, straight, 1},
+		{"trips rule set keeps flush above full house", ShortDeckTripsRules, fullHouse, flush, -1},
+		{"A-6-7-8-9 is the lowest straight", ShortDeckRules, straight, higherStraight, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand1 := EvaluateHandWithRules(tt.hand1, tt.rules)
+			hand2 := EvaluateHandWithRules(tt.hand2, tt.rules)
+			if got := CompareHands(hand1, hand2); got != tt.expected {
+				t.Errorf("CompareHands(%v, %v) = %d, want %d", hand1.Category, hand2.Category, got, tt.expected)
+			}
+			if got := CompareHands(hand2, hand1); got != -tt.expected {
+				t.Errorf("CompareHands(%v, %v) = %d, want %d", hand2.Category, hand1.Category, got, -tt.expected)
+			}
+		})
+	}
+
+	// Hands without rules keep the standard order
+	if got := CompareHands(EvaluateHand(fullHouse), EvaluateHand(flush)); got != 1 {
+		t.Errorf("CompareHands without rules = %d, want 1", got)
+	}
+}
+
+// TestFindBestHandWithRules verifies that the best hand is chosen by the
+// variant's ranking rather than the standard one.
+func TestFindBestHandWithRules(t *testing.T) {
+	// Both a flush and a full house are available
+	cards := mustParseCards(t, "Kh", "Kc", "Ks", "9h", "9d", "7h", "6h", "Th")
+
+	tests := []struct {
+		rules    *RankingRules
+		category HandCategory
+	}{
+		{StandardRules, FullHouse},
+		{ShortDeckRules, Flush},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rules.Name, func(t *testing.T) {
+			hand := FindBestHandWithRules(cards, tt.rules)
+			if hand == nil {
+				t.Fatal("FindBestHandWithRules returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if hand.Rules != tt.rules {
+				t.Errorf("Rules = %v, want %v", hand.Rules, tt.rules)
+			}
+		})
+	}
+
+	straight := FindBestHandWithRules(mustParseCards(t, "Ac", "6d", "7c", "8s", "9h", "Ks", "Kd"), ShortDeckTripsRules)
+	if straight.Category != Straight {
+		t.Errorf("A-6-7-8-9 with a pair of kings = %v, want Straight", straight.Category)
+	}
+	if hand := FindBestHandWithRules(mustParseCards(t, "Ac", "6d", "7c", "8s"), ShortDeckRules); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+}
@@ -0,0 +1,236 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ShowdownPlayer is one player's holding at a community-card showdown.
+type ShowdownPlayer struct {
+	Hole   []Card // The player's private cards
+	Folded bool   // Folded players are not ranked and win nothing
+
+	// Hand, if set, is used instead of evaluating Hole with the board, for
+	// variants with their own hand rules such as FindBestOmahaHand.
+	Hand *Hand
+}
+
+// OddChipRule decides who receives the chips left over when a pot does not
+// divide evenly among its winners.
+type OddChipRule int
+
+const (
+	// OddChipLeftOfButton gives odd chips to the winners closest to the left
+	// of the button, that is, earliest in the players list.
+	OddChipLeftOfButton OddChipRule = iota
+	// OddChipHighCard gives odd chips to the winner holding the highest hole
+	// card, with suits ranked spades, hearts, diamonds, clubs from highest to
+	// lowest to break rank ties.
+	OddChipHighCard
+)
+
+// Pot is an amount of chips and the players who may win it.
+type Pot struct {
+	Amount   int
+	Eligible []int // Player indexes that can win the pot; nil means every player
+}
+
+// PotResult reports how one pot was awarded.
+type PotResult struct {
+	Amount     int
+	Winners    []int // Indexes of players sharing the pot (or its high half), in players order
+	LowWinners []int // Indexes of players sharing the low half; empty unless a low qualified
+	Payouts    []int // Chips won from this pot by each player
+}
+
+// ShowdownResult ranks the players of a showdown.
+type ShowdownResult struct {
+	Hands []*Hand // Best hand per player; nil for folded players
+
+	// TieClasses groups the unfolded players by hand strength, best first;
+	// players within a class tie and are listed in players order.
+	TieClasses [][]int
+
+	highCards []Card // Highest hole card per player, for OddChipHighCard
+}
+
+// suitStrength orders suits for the odd-chip rule: clubs lowest, then
+// diamonds, hearts and spades.
+func suitStrength(s Suit) int {
+	switch s {
+	case Clubs:
+		return 0
+	case Diamonds:
+		return 1
+	case Hearts:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// higherCard reports whether a outranks b by rank, then by suit.
+func higherCard(a, b Card) bool {
+	if a.Rank != b.Rank {
+		return a.Rank > b.Rank
+	}
+	return suitStrength(a.Suit) > suitStrength(b.Suit)
+}
+
+// Showdown evaluates every unfolded player's hole cards with the board
+// using FindBestHand, unless the player's Hand is already set, and ranks
+// them into tie classes. players are listed in
+// seat order starting left of the button, which OddChipLeftOfButton relies
+// on. Use Award to divide pots among the winners.
+// Returns an error if a card is invalid or repeated, an unfolded player has
+// fewer than 5 cards with the board, or no player is left in the hand.
+func Showdown(board []Card, players ...ShowdownPlayer) (*ShowdownResult, error) {
+	all := append([]Card{}, board...)
+	for _, p := range players {
+		all = append(all, p.Hole...)
+	}
+	if _, err := NewCardSet(all); err != nil {
+		return nil, err
+	}
+
+	res := &ShowdownResult{
+		Hands:     make([]*Hand, len(players)),
+		highCards: make([]Card, len(players)),
+	}
+	var active []int
+	for i, p := range players {
+		if p.Folded {
+			continue
+		}
+		res.Hands[i] = p.Hand
+		if p.Hand == nil {
+			cards := append(append([]Card{}, p.Hole...), board...)
+			if len(cards) < 5 {
+				return nil, fmt.Errorf("player %d has %d cards, need at least 5", i+1, len(cards))
+			}
+			res.Hands[i] = FindBestHand(cards)
+		}
+		for k, card := range p.Hole {
+			if k == 0 || higherCard(card, res.highCards[i]) {
+				res.highCards[i] = card
+			}
+		}
+		active = append(active, i)
+	}
+	if len(active) == 0 {
+		return nil, fmt.Errorf("no player is left in the hand")
+	}
+
+	sort.SliceStable(active, func(a, b int) bool {
+		return CompareHands(res.Hands[active[a]], res.Hands[active[b]]) > 0
+	})
+	for k, i := range active {
+		if k > 0 && CompareHands(res.Hands[i], res.Hands[active[k-1]]) == 0 {
+			last := len(res.TieClasses) - 1
+			res.TieClasses[last] = append(res.TieClasses[last], i)
+		} else {
+			res.TieClasses = append(res.TieClasses, []int{i})
+		}
+	}
+	return res, nil
+}
+
+// Winners returns the best players among eligible, or among every
+// unfolded player if eligible is nil.
+func (r *ShowdownResult) Winners(eligible []int) []int {
+	for _, class := range r.TieClasses {
+		var winners []int
+		for _, i := range class {
+			if eligible == nil || slices.Contains(eligible, i) {
+				winners = append(winners, i)
+			}
+		}
+		if len(winners) > 0 {
+			sort.Ints(winners)
+			return winners
+		}
+	}
+	return nil
+}
+
+// oddChipOrder returns winners in the order they receive odd chips.
+func (r *ShowdownResult) oddChipOrder(winners []int, rule OddChipRule) []int {
+	order := append([]int{}, winners...)
+	if rule == OddChipHighCard {
+		sort.SliceStable(order, func(a, b int) bool {
+			return higherCard(r.highCards[order[a]], r.highCards[order[b]])
+		})
+	}
+	return order
+}
+
+// Award divides each pot among its best eligible players, splitting ties
+// evenly and handing any odd chips out one at a time according to rule.
+// It returns one result per pot and each player's total winnings.
+// Returns an error if a pot is negative, names a player that does not
+// exist, or has no unfolded eligible player.
+func (r *ShowdownResult) Award(pots []Pot, rule OddChipRule) ([]PotResult, []int, error) {
+	return r.award(pots, nil, rule)
+}
+
+// award implements Award and AwardHiLo. A nil lows awards every pot to the
+// high hands.
+func (r *ShowdownResult) award(pots []Pot, lows []*LowHand, rule OddChipRule) ([]PotResult, []int, error) {
+	n := len(r.Hands)
+	if lows != nil && len(lows) != n {
+		return nil, nil, fmt.Errorf("got %d players but %d low hands", n, len(lows))
+	}
+	totals := make([]int, n)
+	results := make([]PotResult, len(pots))
+
+	for k, pot := range pots {
+		if pot.Amount < 0 {
+			return nil, nil, fmt.Errorf("pot %d must not be negative, got %d", k+1, pot.Amount)
+		}
+		for _, i := range pot.Eligible {
+			if i < 0 || i >= n {
+				return nil, nil, fmt.Errorf("pot %d names player %d, but there are %d players", k+1, i+1, n)
+			}
+		}
+
+		winners := r.Winners(pot.Eligible)
+		if len(winners) == 0 {
+			return nil, nil, fmt.Errorf("pot %d has no eligible player left in the hand", k+1)
+		}
+		var lowWinners []int
+		if lows != nil {
+			skip := func(i int) bool {
+				return r.Hands[i] == nil || lows[i] == nil || (pot.Eligible != nil && !slices.Contains(pot.Eligible, i))
+			}
+			lowWinners = bestIndexes(n, skip, func(i, j int) int { return CompareLowHands(lows[i], lows[j]) })
+		}
+
+		// An odd chip from halving the pot goes to the high half
+		payouts := make([]int, n)
+		lowHalf := 0
+		if len(lowWinners) > 0 {
+			lowHalf = pot.Amount / 2
+			splitChips(lowHalf, r.oddChipOrder(lowWinners, rule), payouts)
+		}
+		splitChips(pot.Amount-lowHalf, r.oddChipOrder(winners, rule), payouts)
+
+		for i, chips := range payouts {
+			totals[i] += chips
+		}
+		results[k] = PotResult{Amount: pot.Amount, Winners: winners, LowWinners: lowWinners, Payouts: payouts}
+	}
+	return results, totals, nil
+}
+
+// AwardHiLo divides each pot like Award, but splits it between the best
+// eligible high hand and the best eligible qualifying low. lows holds each
+// player's low hand in players order, nil for no qualifying low. A pot with
+// no qualifying low goes entirely to the high hands, and an odd chip from
+// halving a pot goes to the high half.
+func (r *ShowdownResult) AwardHiLo(pots []Pot, lows []*LowHand, rule OddChipRule) ([]PotResult, []int, error) {
+	if lows == nil {
+		lows = make([]*LowHand, len(r.Hands))
+	}
+	return r.award(pots, lows, rule)
+}
@@ -0,0 +1,189 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// player builds a ShowdownPlayer from card notation.
+func player(t *testing.T, hole ...string) ShowdownPlayer {
+	t.Helper()
+	return ShowdownPlayer{Hole: mustParseCards(t, hole...)}
+}
+
+// TestShowdownTieClasses verifies ranking of any number of players into
+// tie classes, best first.
+func TestShowdownTieClasses(t *testing.T) {
+	folded := player(t, "Ah", "Ad")
+	folded.Folded = true
+
+	tests := []struct {
+		name    string
+		board   []string
+		players []ShowdownPlayer
+		classes [][]int
+	}{
+		{
+			"distinct hands",
+			[]string{"Kh", "9d", "5c", "2s", "7h"},
+			[]ShowdownPlayer{player(t, "Qc", "Jc"), player(t, "Ks", "3d"), player(t, "9h", "9s")},
+			[][]int{{2}, {1}, {0}},
+		},
+		{
+			"board plays for everyone",
+			[]string{"Th", "Jd", "Qc", "Ks", "Ah"},
+			[]ShowdownPlayer{player(t, "2c", "3d"), player(t, "4s", "5h"), player(t, "6c", "7d")},
+			[][]int{{0, 1, 2}},
+		},
+		{
+			"tie below the winner",
+			[]string{"Kh", "9d", "5c", "2s", "7h"},
+			[]ShowdownPlayer{player(t, "Ac", "4d"), player(t, "5s", "5h"), player(t, "As", "4c")},
+			[][]int{{1}, {0, 2}},
+		},
+		{
+			"folded players are not ranked",
+			[]string{"Kh", "9d", "5c", "2s", "7h"},
+			[]ShowdownPlayer{player(t, "Qc", "Jc"), folded, player(t, "Ks", "3d")},
+			[][]int{{2}, {0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Showdown(mustParseCards(t, tt.board...), tt.players...)
+			if err != nil {
+				t.Fatalf("Showdown: %v", err)
+			}
+			if !reflect.DeepEqual(res.TieClasses, tt.classes) {
+				t.Errorf("TieClasses = %v, want %v", res.TieClasses, tt.classes)
+			}
+			for i, p := range tt.players {
+				if (res.Hands[i] == nil) != p.Folded {
+					t.Errorf("Hands[%d] = %v, folded %v", i, res.Hands[i], p.Folded)
+				}
+			}
+		})
+	}
+}
+
+// TestShowdownAward verifies per-pot winners, even splits and both
+// odd-chip rules.
+func TestShowdownAward(t *testing.T) {
+	board := mustParseCards(t, "Th", "Jd", "Qc", "Ks", "2h")
+
+	tests := []struct {
+		name    string
+		players []ShowdownPlayer
+		pots    []Pot
+		rule    OddChipRule
+		winners [][]int
+		totals  []int
+	}{
+		{
+			"single winner",
+			[]ShowdownPlayer{player(t, "Ac", "3d"), player(t, "Kh", "Kd"), player(t, "4c", "5d")},
+			[]Pot{{Amount: 300}},
+			OddChipLeftOfButton,
+			[][]int{{0}},
+			[]int{300, 0, 0},
+		},
+		{
+			"odd chip left of the button",
+			[]ShowdownPlayer{player(t, "4c", "5d"), player(t, "Ac", "3d"), player(t, "Ad", "3c")},
+			[]Pot{{Amount: 101}},
+			OddChipLeftOfButton,
+			[][]int{{1, 2}},
+			[]int{0, 51, 50},
+		},
+		{
+			"odd chip to the high card by suit",
+			[]ShowdownPlayer{player(t, "4c", "5d"), player(t, "Ac", "3d"), player(t, "As", "3c")},
+			[]Pot{{Amount: 101}},
+			OddChipHighCard,
+			[][]int{{1, 2}},
+			[]int{0, 50, 51},
+		},
+		{
+			"two odd chips among three winners",
+			[]ShowdownPlayer{player(t, "Ac", "3d"), player(t, "Ad", "4c"), player(t, "Ah", "5c")},
+			[]Pot{{Amount: 20}},
+			OddChipHighCard,
+			[][]int{{0, 1, 2}},
+			[]int{6, 7, 7},
+		},
+		{
+			"side pot goes to the best eligible player",
+			[]ShowdownPlayer{player(t, "Ac", "3d"), player(t, "Kh", "Kd"), player(t, "Qh", "4c")},
+			[]Pot{{Amount: 300}, {Amount: 200, Eligible: []int{1, 2}}},
+			OddChipLeftOfButton,
+			[][]int{{0}, {1}},
+			[]int{300, 200, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Showdown(board, tt.players...)
+			if err != nil {
+				t.Fatalf("Showdown: %v", err)
+			}
+			pots, totals, err := res.Award(tt.pots, tt.rule)
+			if err != nil {
+				t.Fatalf("Award: %v", err)
+			}
+			for k, pot := range pots {
+				if !reflect.DeepEqual(pot.Winners, tt.winners[k]) {
+					t.Errorf("pot %d Winners = %v, want %v", k, pot.Winners, tt.winners[k])
+				}
+				sum := 0
+				for _, chips := range pot.Payouts {
+					sum += chips
+				}
+				if sum != pot.Amount {
+					t.Errorf("pot %d pays out %d, want %d", k, sum, pot.Amount)
+				}
+			}
+			if !reflect.DeepEqual(totals, tt.totals) {
+				t.Errorf("totals = %v, want %v", totals, tt.totals)
+			}
+		})
+	}
+}
+
+// TestShowdownErrors verifies rejection of bad cards, players and pots.
+func TestShowdownErrors(t *testing.T) {
+	board := mustParseCards(t, "Th", "Jd", "Qc", "Ks", "2h")
+	folded := player(t, "9c", "9d")
+	folded.Folded = true
+
+	if _, err := Showdown(board, player(t, "Th", "3d"), player(t, "4c", "5d")); err == nil {
+		t.Error("card repeated on the board should fail")
+	}
+	if _, err := Showdown(board, folded); err == nil {
+		t.Error("showdown with every player folded should fail")
+	}
+	_, err := Showdown(board[:2], folded, player(t, "Ac", "3d"))
+	if want := "player 2 has 4 cards, need at least 5"; err == nil || err.Error() != want {
+		t.Errorf("fewer than 5 cards: error = %v, want %q", err, want)
+	}
+
+	res, err := Showdown(board, player(t, "Ac", "3d"), folded)
+	if err != nil {
+		t.Fatalf("Showdown: %v", err)
+	}
+	badPots := []struct {
+		name    string
+		pots    []Pot
+		wantErr string
+	}{
+		{"negative pot", []Pot{{Amount: -1}}, "pot 1 must not be negative, got -1"},
+		{"unknown player", []Pot{{Amount: 10}, {Amount: 10, Eligible: []int{2}}}, "pot 2 names player 3, but there are 2 players"},
+		{"only folded players eligible", []Pot{{Amount: 10, Eligible: []int{1}}}, "pot 1 has no eligible player left in the hand"},
+	}
+	for _, tt := range badPots {
+		if _, _, err := res.Award(tt.pots, OddChipLeftOfButton); err == nil || err.Error() != tt.wantErr {
+			t.Errorf("%s: Award error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
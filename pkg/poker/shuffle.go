@@ -0,0 +1,61 @@
+package poker
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// seedStream is the fixed PCG stream used by NewSeededSource, so that a seed
+// alone determines the shuffled order.
+const seedStream = 0x9E3779B97F4A7C15
+
+// CryptoSource is a rand.Source backed by crypto/rand. Shuffles drawn from it
+// are unpredictable, which is what real-money play requires.
+// The zero value is ready to use.
+type CryptoSource struct{}
+
+// Uint64 returns a uniformly distributed value read from crypto/rand.
+func (CryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic("poker: crypto/rand failed: " + err.Error())
+	}
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// NewSeededSource returns a deterministic rand.Source (PCG) for the given seed.
+// Shuffling equal decks with sources built from the same seed always produces
+// the same deal order, which makes tests and hand replays reproducible.
+func NewSeededSource(seed uint64) rand.Source {
+	return rand.NewPCG(seed, seedStream)
+}
+
+// uniformBelow returns a uniformly distributed value in [0, n) using
+// Lemire's multiply-and-shift method with rejection, so there is no modulo bias.
+func uniformBelow(src rand.Source, n uint64) uint64 {
+	hi, lo := bits.Mul64(src.Uint64(), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul64(src.Uint64(), n)
+		}
+	}
+	return hi
+}
+
+// Shuffle randomizes the order of the cards remaining in the deck using the
+// Fisher-Yates algorithm. src supplies the randomness: pass CryptoSource{}
+// for unpredictable shuffles or NewSeededSource(seed) for reproducible ones.
+// A nil src uses CryptoSource.
+func (d *Deck) Shuffle(src rand.Source) {
+	if src == nil {
+		src = CryptoSource{}
+	}
+
+	for i := len(d.Cards) - 1; i > 0; i-- {
+		j := uniformBelow(src, uint64(i+1))
+		d.Cards[i], d.Cards[j] = d.Cards[j], d.Cards[i]
+	}
+}
@@ -0,0 +1,199 @@
+package poker
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+// Chi-square critical values at a significance level of 1e-6, so that the
+// tests using CryptoSource essentially never fail by chance.
+const (
+	chiSquareCritical23   = 71.2   // 23 degrees of freedom
+	chiSquareCritical51   = 114.5  // 51 degrees of freedom
+	chiSquareCritical2601 = 2958.4 // 2601 degrees of freedom
+)
+
+// chiSquare computes the chi-square statistic of observed counts against a
+// uniform expectation.
+func chiSquare(observed []int, expected float64) float64 {
+	sum := 0.0
+	for _, o := range observed {
+		d := float64(o) - expected
+		sum += d * d / expected
+	}
+	return sum
+}
+
+// shuffleSources returns the sources every uniformity test runs against.
+func shuffleSources() map[string]func() rand.Source {
+	seeded := NewSeededSource(2024)
+	return map[string]func() rand.Source{
+		"seeded": func() rand.Source { return seeded },
+		"crypto": func() rand.Source { return CryptoSource{} },
+	}
+}
+
+// TestShuffleSeededIsReproducible verifies that equal seeds give equal deal
+// orders and different seeds give different ones.
+func TestShuffleSeededIsReproducible(t *testing.T) {
+	deck1 := NewDeck()
+	deck2 := NewDeck()
+	deck3 := NewDeck()
+
+	deck1.Shuffle(NewSeededSource(7))
+	deck2.Shuffle(NewSeededSource(7))
+	deck3.Shuffle(NewSeededSource(8))
+
+	if !reflect.DeepEqual(deck1.Cards, deck2.Cards) {
+		t.Error("decks shuffled with the same seed should be identical")
+	}
+	if reflect.DeepEqual(deck1.Cards, deck3.Cards) {
+		t.Error("decks shuffled with different seeds should differ")
+	}
+}
+
+// TestShuffleSeededGoldenOrder pins the first cards dealt for a fixed seed,
+// so that stored replays keep working across releases.
+func TestShuffleSeededGoldenOrder(t *testing.T) {
+	deck := NewDeck()
+	deck.Shuffle(NewSeededSource(1))
+
+	want := mustParseCards(t, "3s", "Qd", "Ad", "9d", "5h")
+	if !reflect.DeepEqual(deck.Cards[:5], want) {
+		t.Errorf("first five cards for seed 1 = %v, want %v", deck.Cards[:5], want)
+	}
+}
+
+// TestShuffleIsPermutation verifies that shuffling keeps every card exactly once.
+func TestShuffleIsPermutation(t *testing.T) {
+	for name, source := range shuffleSources() {
+		t.Run(name, func(t *testing.T) {
+			deck := NewDeck()
+			deck.Shuffle(source())
+
+			set, err := NewCardSet(deck.Cards)
+			if err != nil {
+				t.Fatalf("shuffled deck is not a set of distinct cards: %v", err)
+			}
+			if set != FullDeckSet {
+				t.Errorf("shuffled deck = %v, want all 52 cards", set)
+			}
+		})
+	}
+}
+
+// TestShuffleNilSourceUsesCrypto verifies that a nil source still shuffles.
+func TestShuffleNilSourceUsesCrypto(t *testing.T) {
+	deck := NewDeck()
+	deck.Shuffle(nil)
+
+	if reflect.DeepEqual(deck.Cards, NewDeck().Cards) {
+		t.Error("Shuffle(nil) left the deck in its original order")
+	}
+}
+
+// TestShuffleEmptyAndSingleCardDecks verifies the degenerate deck sizes.
+func TestShuffleEmptyAndSingleCardDecks(t *testing.T) {
+	empty := &Deck{}
+	empty.Shuffle(NewSeededSource(1))
+	if len(empty.Cards) != 0 {
+		t.Errorf("empty deck has %d cards after Shuffle", len(empty.Cards))
+	}
+
+	single := &Deck{Cards: []Card{{Rank: Ace, Suit: Spades}}}
+	single.Shuffle(NewSeededSource(1))
+	if single.Cards[0] != (Card{Rank: Ace, Suit: Spades}) {
+		t.Errorf("single-card deck changed to %v", single.Cards)
+	}
+}
+
+// TestShuffleTopCardUniform checks that every card is equally likely to end
+// up on top of the deck.
+func TestShuffleTopCardUniform(t *testing.T) {
+	const trials = 52 * 1000
+
+	for name, source := range shuffleSources() {
+		t.Run(name, func(t *testing.T) {
+			src := source()
+			counts := make([]int, NumCards)
+			for i := 0; i < trials; i++ {
+				deck := NewDeck()
+				deck.Shuffle(src)
+				counts[deck.Cards[0].Index()]++
+			}
+
+			if stat := chiSquare(counts, trials/NumCards); stat > chiSquareCritical51 {
+				t.Errorf("top card chi-square = %.1f, want <= %.1f", stat, chiSquareCritical51)
+			}
+		})
+	}
+}
+
+// TestShufflePositionMatrixUniform checks that every card is equally likely
+// to land in every position, across the full 52x52 card/position matrix.
+func TestShufflePositionMatrixUniform(t *testing.T) {
+	const trials = 20000
+
+	for name, source := range shuffleSources() {
+		t.Run(name, func(t *testing.T) {
+			src := source()
+			counts := make([]int, NumCards*NumCards)
+			for i := 0; i < trials; i++ {
+				deck := NewDeck()
+				deck.Shuffle(src)
+				for pos, card := range deck.Cards {
+					counts[int(card.Index())*NumCards+pos]++
+				}
+			}
+
+			if stat := chiSquare(counts, float64(trials)/NumCards); stat > chiSquareCritical2601 {
+				t.Errorf("position matrix chi-square = %.1f, want <= %.1f", stat, chiSquareCritical2601)
+			}
+		})
+	}
+}
+
+// TestShuffleAllPermutationsUniform checks that all 24 orderings of a
+// 4-card deck occur equally often, which catches biased swap schemes that
+// still place each card uniformly.
+func TestShuffleAllPermutationsUniform(t *testing.T) {
+	const trials = 24 * 2000
+	cards := mustParseCards(t, "Ah", "Kd", "Qc", "Js")
+
+	for name, source := range shuffleSources() {
+		t.Run(name, func(t *testing.T) {
+			src := source()
+			seen := make(map[[4]Card]int)
+			for i := 0; i < trials; i++ {
+				deck := &Deck{Cards: append([]Card(nil), cards...)}
+				deck.Shuffle(src)
+				seen[[4]Card(deck.Cards)]++
+			}
+
+			if len(seen) != 24 {
+				t.Fatalf("observed %d distinct orderings, want 24", len(seen))
+			}
+			counts := make([]int, 0, 24)
+			for _, n := range seen {
+				counts = append(counts, n)
+			}
+			if stat := chiSquare(counts, trials/24); stat > chiSquareCritical23 {
+				t.Errorf("permutation chi-square = %.1f, want <= %.1f", stat, chiSquareCritical23)
+			}
+		})
+	}
+}
+
+// TestUniformBelowRange verifies bounded draws stay in range for small and
+// large bounds.
+func TestUniformBelowRange(t *testing.T) {
+	src := NewSeededSource(99)
+	for _, n := range []uint64{1, 2, 3, 52, 1<<63 + 1} {
+		for i := 0; i < 1000; i++ {
+			if v := uniformBelow(src, n); v >= n {
+				t.Fatalf("uniformBelow(%d) = %d, out of range", n, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WildMode selects what a joker may stand for.
+type WildMode int
+
+const (
+	WildFull WildMode = iota // Jokers may stand for any card
+	WildBug                  // Jokers are bugs: they play as an ace, or complete a straight or flush
+)
+
+// WildRules configures wild-card evaluation. Jokers are always wild and play
+// according to Mode; natural cards whose rank is in WildRanks are fully wild
+// in either mode (for example []Rank{Two} for deuces wild).
+type WildRules struct {
+	Mode      WildMode
+	WildRanks []Rank
+}
+
+// splitWilds separates natural cards from wild cards and validates them.
+// full counts cards that may stand for anything; bugs counts jokers limited
+// by the bug rule.
+func (r WildRules) splitWilds(cards []Card) (naturals []Card, full, bugs int, err error) {
+	var jokers, regular []Card
+	for _, card := range cards {
+		if card.IsJoker() {
+			jokers = append(jokers, card)
+		} else {
+			regular = append(regular, card)
+		}
+	}
+
+	if _, err := NewCardSet(regular); err != nil {
+		return nil, 0, 0, err
+	}
+	for i, joker := range jokers {
+		if joker != RedJoker && joker != BlackJoker {
+			return nil, 0, 0, fmt.Errorf("invalid joker: %v", joker)
+		}
+		if slices.Contains(jokers[:i], joker) {
+			return nil, 0, 0, fmt.Errorf("duplicate card: %v", joker)
+		}
+	}
+
+	if r.Mode == WildBug {
+		bugs = len(jokers)
+	} else {
+		full = len(jokers)
+	}
+	for _, card := range regular {
+		if slices.Contains(r.WildRanks, card.Rank) {
+			full++
+		} else {
+			naturals = append(naturals, card)
+		}
+	}
+	return naturals, full, bugs, nil
+}
+
+// completesStraightOrFlush reports whether a bug may play as a non-ace in
+// a hand of the given category.
+func completesStraightOrFlush(c HandCategory) bool {
+	return c == Straight || c == Flush || c == StraightFlush || c == RoyalFlush
+}
+
+// wildRankChoices calls fn with every non-decreasing sequence of k ranks,
+// which covers every way to assign ranks to k interchangeable wild cards.
+func wildRankChoices(k int, fn func(ranks []Rank)) {
+	ranks := make([]Rank, k)
+	var choose func(i int, from Rank)
+	choose = func(i int, from Rank) {
+		if i == k {
+			fn(ranks)
+			return
+		}
+		for r := from; r <= Ace; r++ {
+			ranks[i] = r
+			choose(i+1, r)
+		}
+	}
+	choose(0, Two)
+}
+
+// bestWildAssignment returns the category and tiebreakers of the best hand
+// that natural cards plus full and bug wild cards can make, totalling 5.
+func bestWildAssignment(naturals []Card, full, bugs int) (HandCategory, []Rank) {
+	if len(naturals) == 0 {
+		// Any mix of wild cards, bugs included, makes five aces
+		return FiveOfAKind, []Rank{Ace}
+	}
+
+	// A flush is only possible if every natural card shares a suit
+	flushSuit := naturals[0].Suit
+	flushable := true
+	for _, card := range naturals {
+		flushable = flushable && card.Suit == flushSuit
+	}
+	otherSuit := (flushSuit + 1) % 4
+
+	var best *Hand
+	cards := make([]Card, 5)
+	copy(cards, naturals)
+	n := len(naturals)
+
+	wildRankChoices(full, func(fullRanks []Rank) {
+		wildRankChoices(bugs, func(bugRanks []Rank) {
+			var counts [Ace + 1]int
+			for _, card := range naturals {
+				counts[card.Rank]++
+			}
+			i := n
+			for _, wilds := range [][]Rank{fullRanks, bugRanks} {
+				for _, r := range wilds {
+					counts[r]++
+					cards[i] = Card{Rank: r, Suit: otherSuit}
+					i++
+				}
+			}
+
+			var hand *Hand
+			distinct := true
+			for r := Two; r <= Ace; r++ {
+				if counts[r] == 5 {
+					hand = &Hand{Category: FiveOfAKind, Tiebreakers: []Rank{r}}
+				}
+				distinct = distinct && counts[r] <= 1
+			}
+			if hand == nil {
+				// With distinct ranks and suited naturals the wilds complete
+				// the flush; otherwise they must not create an accidental one
+				if distinct && flushable {
+					for i := n; i < 5; i++ {
+						cards[i].Suit = flushSuit
+					}
+				}
+				hand = EvaluateHand(cards)
+			}
+
+			// A bug that is not an ace must complete a straight or flush
+			for _, r := range bugRanks {
+				if r != Ace && !completesStraightOrFlush(hand.Category) {
+					return
+				}
+			}
+
+			if best == nil || CompareHands(hand, best) > 0 {
+				best = &Hand{Category: hand.Category, Tiebreakers: slices.Clone(hand.Tiebreakers)}
+			}
+		})
+	})
+	return best.Category, best.Tiebreakers
+}
+
+// EvaluateWildHand evaluates exactly 5 cards, some of which may be wild, and
+// assigns the wild cards to make the strongest possible hand. Five of a
+// kind ranks above a royal flush. The returned Hand keeps the original
+// cards, with its category and tiebreakers describing the completed hand.
+// Returns nil if the input is not exactly 5 cards or a card is invalid or
+// repeated.
+func EvaluateWildHand(cards []Card, rules WildRules) *Hand {
+	if len(cards) != 5 {
+		return nil
+	}
+	naturals, full, bugs, err := rules.splitWilds(cards)
+	if err != nil {
+		return nil
+	}
+	if full+bugs == 0 {
+		return EvaluateHand(cards)
+	}
+
+	category, tiebreakers := bestWildAssignment(naturals, full, bugs)
+	return &Hand{
+		Cards:       cards,
+		Category:    category,
+		Tiebreakers: tiebreakers,
+	}
+}
+
+// FindBestWildHand finds the best 5-card hand from 5 or more cards, some of
+// which may be wild. Without wild cards it is equivalent to FindBestHand.
+// Returns nil if fewer than 5 cards are provided or a card is invalid or
+// repeated.
+func FindBestWildHand(cards []Card, rules WildRules) *Hand {
+	if len(cards) < 5 {
+		return nil
+	}
+	_, full, bugs, err := rules.splitWilds(cards)
+	if err != nil {
+		return nil
+	}
+	if full+bugs == 0 {
+		return FindBestHand(cards)
+	}
+
+	var best *Hand
+	for _, combo := range Combinations(cards, 5) {
+		if hand := EvaluateWildHand(combo, rules); best == nil || CompareHands(hand, best) > 0 {
+			best = hand
+		}
+	}
+	return best
+}
@@ -0,0 +1,129 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEvaluateWildHand verifies optimal wild-card assignment for jokers,
+// deuces wild and the bug rule.
+func TestEvaluateWildHand(t *testing.T) {
+	deucesWild := WildRules{WildRanks: []Rank{Two}}
+	bug := WildRules{Mode: WildBug}
+
+	tests := []struct {
+		name        string
+		cards       []string
+		rules       WildRules
+		category    HandCategory
+		tiebreakers []Rank
+	}{
+		{"joker completes a royal flush", []string{"Ah", "Kh", "Qh", "Jh", "Jr"}, WildRules{}, RoyalFlush, []Rank{}},
+		{"joker with four aces is five of a kind", []string{"Ah", "Ad", "Ac", "As", "Jb"}, WildRules{}, FiveOfAKind, []Rank{Ace}},
+		{"two jokers make quads", []string{"9h", "9d", "5c", "Jr", "Jb"}, WildRules{}, FourOfAKind, []Rank{Nine, Five}},
+		{"joker makes the best full house", []string{"Kh", "Kd", "4c", "4s", "Jr"}, WildRules{}, FullHouse, []Rank{King, Four}},
+		{"joker fills an inside straight", []string{"9c", "Td", "Qh", "Ks", "Jr"}, WildRules{}, Straight, []Rank{King}},
+		{"joker completes a flush with an ace", []string{"2h", "5h", "9h", "Jh", "Jr"}, WildRules{}, Flush, []Rank{Ace, Jack, Nine, Five, Two}},
+		{"joker pairs the highest card", []string{"Kh", "9d", "7c", "4s", "Jr"}, WildRules{}, OnePair, []Rank{King, Nine, Seven, Four}},
+		{"deuces wild five of a kind", []string{"2c", "2d", "9h", "9s", "9c"}, deucesWild, FiveOfAKind, []Rank{Nine}},
+		{"deuces and a joker make a wheel", []string{"Ah", "3c", "4s", "2d", "Jr"}, deucesWild, Straight, []Rank{Five}},
+		{"natural deuces are not wild without the rule", []string{"2c", "2d", "9h", "9s", "9c"}, WildRules{}, FullHouse, []Rank{Nine, Two}},
+		{"all wild is five aces", []string{"2c", "2d", "2h", "Jr", "Jb"}, deucesWild, FiveOfAKind, []Rank{Ace}},
+		{"bug plays as an ace kicker, not a fourth king", []string{"Kh", "Kd", "Ks", "7c", "Jr"}, bug, ThreeOfAKind, []Rank{King, Ace, Seven}},
+		{"bug completes a straight", []string{"9c", "Td", "Jh", "Qs", "Jr"}, bug, Straight, []Rank{King}},
+		{"bug completes a flush", []string{"2h", "5h", "9h", "Jh", "Jr"}, bug, Flush, []Rank{Ace, Jack, Nine, Five, Two}},
+		{"bug completes a straight flush", []string{"5s", "6s", "7s", "8s", "Jb"}, bug, StraightFlush, []Rank{Nine}},
+		{"bug pairs an ace", []string{"As", "Kd", "8c", "4h", "Jr"}, bug, OnePair, []Rank{Ace, King, Eight, Four}},
+		{"bug cannot pair a seven", []string{"7h", "Kd", "3c", "4s", "Jr"}, bug, HighCard, []Rank{Ace, King, Seven, Four, Three}},
+		{"bug makes five aces", []string{"Ah", "Ad", "Ac", "As", "Jr"}, bug, FiveOfAKind, []Rank{Ace}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards := mustParseCards(t, tt.cards...)
+			hand := EvaluateWildHand(cards, tt.rules)
+			if hand == nil {
+				t.Fatal("EvaluateWildHand returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+			if !reflect.DeepEqual(hand.Tiebreakers, tt.tiebreakers) {
+				t.Errorf("Tiebreakers = %v, want %v", hand.Tiebreakers, tt.tiebreakers)
+			}
+			if !reflect.DeepEqual(hand.Cards, cards) {
+				t.Errorf("Cards = %v, want the original %v", hand.Cards, cards)
+			}
+		})
+	}
+}
+
+// TestEvaluateWildHandInvalidInput verifies nil for bad card counts or cards.
+func TestEvaluateWildHandInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"four cards", mustParseCards(t, "Ah", "Kh", "Qh", "Jr")},
+		{"repeated joker", mustParseCards(t, "Ah", "Kh", "Qh", "Jr", "Jr")},
+		{"repeated card", mustParseCards(t, "Ah", "Ah", "Qh", "Jh", "Jr")},
+		{"invalid joker", []Card{{Rank: Joker, Suit: Clubs}, {Rank: Ace, Suit: Hearts}, {Rank: King, Suit: Hearts}, {Rank: Queen, Suit: Hearts}, {Rank: Jack, Suit: Hearts}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if hand := EvaluateWildHand(tt.cards, WildRules{}); hand != nil {
+				t.Errorf("EvaluateWildHand = %v, want nil", hand)
+			}
+		})
+	}
+}
+
+// TestFindBestWildHand verifies best-hand selection from 7 cards with wilds.
+func TestFindBestWildHand(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []string
+		rules    WildRules
+		category HandCategory
+	}{
+		{"joker makes a royal flush", []string{"Jr", "Ah", "Kh", "3c", "9d", "Qh", "Th"}, WildRules{}, RoyalFlush},
+		{"joker makes five of a kind", []string{"Jr", "8h", "8d", "8c", "8s", "Kh", "2c"}, WildRules{}, FiveOfAKind},
+		{"bug plays as an ace", []string{"Jr", "Ah", "Ad", "Kc", "Ks", "7h", "2c"}, WildRules{Mode: WildBug}, FullHouse},
+		{"deuces wild", []string{"2h", "2d", "Ac", "As", "Kh", "Qd", "7s"}, WildRules{WildRanks: []Rank{Two}}, FourOfAKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := FindBestWildHand(mustParseCards(t, tt.cards...), tt.rules)
+			if hand == nil {
+				t.Fatal("FindBestWildHand returned nil")
+			}
+			if hand.Category != tt.category {
+				t.Errorf("Category = %v, want %v", hand.Category, tt.category)
+			}
+		})
+	}
+
+	// Without wild cards the result matches FindBestHand
+	cards := mustParseCards(t, "Ah", "Kd", "Qc", "Js", "9h", "2c", "2d")
+	if got, want := FindBestWildHand(cards, WildRules{}), FindBestHand(cards); CompareHands(got, want) != 0 {
+		t.Errorf("FindBestWildHand = %v, want %v", got, want)
+	}
+	if hand := FindBestWildHand(mustParseCards(t, "Jr", "Ah", "Kh", "Qh"), WildRules{}); hand != nil {
+		t.Errorf("4 cards returned %v, want nil", hand)
+	}
+}
+
+// TestFiveOfAKindRanksAboveRoyalFlush verifies the new category's position.
+func TestFiveOfAKindRanksAboveRoyalFlush(t *testing.T) {
+	five := EvaluateWildHand(mustParseCards(t, "2h", "2d", "2c", "2s", "Jr"), WildRules{})
+	royal := EvaluateHand(mustParseCards(t, "Ah", "Kh", "Qh", "Jh", "Th"))
+
+	if five.Category != FiveOfAKind {
+		t.Fatalf("Category = %v, want Five of a Kind", five.Category)
+	}
+	if CompareHands(five, royal) != 1 {
+		t.Error("five deuces should beat a royal flush")
+	}
+}
@@ -0,0 +1,264 @@
+// Package stud deals seven-card stud and determines the order of action
+// and the showdown winners, using package poker for cards and evaluation.
+package stud
+
+import (
+	"fmt"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// Street identifies a betting round in seven-card stud by the number of
+// cards each player holds once it has been dealt.
+type Street int
+
+const (
+	NotDealt      Street = 0
+	ThirdStreet   Street = 3 // Two down cards and one up card
+	FourthStreet  Street = 4 // One more up card
+	FifthStreet   Street = 5 // One more up card
+	SixthStreet   Street = 6 // One more up card
+	SeventhStreet Street = 7 // One final down card (the river)
+)
+
+// MaxPlayers is the largest table seven-card stud supports. With 8 players
+// the deck can run out on seventh street; see Game.Community.
+const MaxPlayers = 8
+
+// String returns the street name, such as "Third Street".
+func (s Street) String() string {
+	switch s {
+	case NotDealt:
+		return "Not Dealt"
+	case ThirdStreet:
+		return "Third Street"
+	case FourthStreet:
+		return "Fourth Street"
+	case FifthStreet:
+		return "Fifth Street"
+	case SixthStreet:
+		return "Sixth Street"
+	case SeventhStreet:
+		return "Seventh Street"
+	default:
+		return "Unknown"
+	}
+}
+
+// Player holds one seat's cards.
+type Player struct {
+	Down   []poker.Card // Face-down cards, in the order dealt
+	Up     []poker.Card // Face-up cards, in the order dealt
+	Folded bool
+}
+
+// Cards returns all of the player's cards: down cards first, then up cards.
+func (p *Player) Cards() []poker.Card {
+	cards := make([]poker.Card, 0, len(p.Down)+len(p.Up))
+	cards = append(cards, p.Down...)
+	return append(cards, p.Up...)
+}
+
+// Game is a hand of seven-card stud. Seats are numbered clockwise starting
+// left of the dealer; cards are dealt one at a time in seat order.
+type Game struct {
+	Deck    *poker.Deck
+	Players []Player
+	Street  Street
+
+	// Community is a single up card shared by every player, dealt on
+	// seventh street instead of individual river cards when the deck
+	// cannot supply one card per remaining player.
+	Community *poker.Card
+}
+
+// NewGame starts a hand for the given number of players (2 to MaxPlayers)
+// using deck, which should already be shuffled. No cards are dealt until
+// DealStreet is called.
+func NewGame(deck *poker.Deck, players int) (*Game, error) {
+	if deck == nil {
+		return nil, fmt.Errorf("deck is nil")
+	}
+	if players < 2 || players > MaxPlayers {
+		return nil, fmt.Errorf("seven-card stud needs 2 to %d players, got %d", MaxPlayers, players)
+	}
+	return &Game{Deck: deck, Players: make([]Player, players)}, nil
+}
+
+// Active returns the seats that have not folded, in seat order.
+func (g *Game) Active() []int {
+	var seats []int
+	for i := range g.Players {
+		if !g.Players[i].Folded {
+			seats = append(seats, i)
+		}
+	}
+	return seats
+}
+
+// Fold removes a seat from the hand.
+func (g *Game) Fold(seat int) error {
+	if seat < 0 || seat >= len(g.Players) {
+		return fmt.Errorf("seat %d does not exist", seat)
+	}
+	if g.Players[seat].Folded {
+		return fmt.Errorf("seat %d has already folded", seat)
+	}
+	g.Players[seat].Folded = true
+	return nil
+}
+
+// dealRound deals one card to every active player, face up or down.
+func (g *Game) dealRound(up bool) error {
+	for _, seat := range g.Active() {
+		cards, err := g.Deck.Deal(1)
+		if err != nil {
+			return fmt.Errorf("dealing to seat %d: %w", seat, err)
+		}
+		p := &g.Players[seat]
+		if up {
+			p.Up = append(p.Up, cards[0])
+		} else {
+			p.Down = append(p.Down, cards[0])
+		}
+	}
+	return nil
+}
+
+// DealStreet deals the next street to every active player: two down cards
+// and one up card on third street, one up card on fourth through sixth
+// street, and one down card on seventh street. If the deck is too short
+// for every player's seventh-street card, one community card is dealt
+// face up instead.
+func (g *Game) DealStreet() error {
+	switch g.Street {
+	case NotDealt:
+		for _, up := range []bool{false, false, true} {
+			if err := g.dealRound(up); err != nil {
+				return err
+			}
+		}
+		g.Street = ThirdStreet
+	case ThirdStreet, FourthStreet, FifthStreet:
+		if err := g.dealRound(true); err != nil {
+			return err
+		}
+		g.Street++
+	case SixthStreet:
+		if len(g.Deck.Cards) < len(g.Active()) {
+			cards, err := g.Deck.Deal(1)
+			if err != nil {
+				return fmt.Errorf("dealing the community card: %w", err)
+			}
+			card := cards[0]
+			g.Community = &card
+		} else if err := g.dealRound(false); err != nil {
+			return err
+		}
+		g.Street = SeventhStreet
+	default:
+		return fmt.Errorf("all streets have been dealt")
+	}
+	return nil
+}
+
+// suitOrder ranks suits for the bring-in: clubs, diamonds, hearts, spades
+// from lowest to highest.
+func suitOrder(s poker.Suit) int {
+	switch s {
+	case poker.Clubs:
+		return 0
+	case poker.Diamonds:
+		return 1
+	case poker.Hearts:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// BringIn returns the seat forced to bring in on third street: the player
+// with the lowest up card, aces high. Equal ranks are broken by suit, with
+// clubs lowest, then diamonds, hearts and spades.
+func (g *Game) BringIn() (int, error) {
+	if g.Street != ThirdStreet {
+		return -1, fmt.Errorf("bring-in is only determined on third street, not %v", g.Street)
+	}
+
+	seat := -1
+	var lowest poker.Card
+	for _, i := range g.Active() {
+		card := g.Players[i].Up[0]
+		if seat < 0 || card.Rank < lowest.Rank ||
+			(card.Rank == lowest.Rank && suitOrder(card.Suit) < suitOrder(lowest.Suit)) {
+			seat, lowest = i, card
+		}
+	}
+	return seat, nil
+}
+
+// VisibleHand ranks a seat's up cards with poker.EvaluatePartialHand for
+// deciding who acts first. Only pairs, two pair, trips and quads count;
+// straights and flushes do not. Returns nil if the seat has no up cards
+// or does not exist.
+func (g *Game) VisibleHand(seat int) *poker.Hand {
+	if seat < 0 || seat >= len(g.Players) {
+		return nil
+	}
+	return poker.EvaluatePartialHand(g.Players[seat].Up)
+}
+
+// FirstToAct returns the seat that acts first on the current street: the
+// bring-in on third street, and afterwards the player showing the best
+// visible hand. Equal visible hands go to the lowest seat.
+func (g *Game) FirstToAct() (int, error) {
+	switch g.Street {
+	case NotDealt:
+		return -1, fmt.Errorf("no cards have been dealt")
+	case ThirdStreet:
+		return g.BringIn()
+	}
+
+	seat := -1
+	var best *poker.Hand
+	for _, i := range g.Active() {
+		if hand := g.VisibleHand(i); seat < 0 || poker.CompareHands(hand, best) > 0 {
+			seat, best = i, hand
+		}
+	}
+	return seat, nil
+}
+
+// ShowdownResult holds each seat's best hand and the winning seats.
+type ShowdownResult struct {
+	Hands   []*poker.Hand // Best 5-card hand per seat; nil for folded players
+	Winners []int         // Seats sharing the pot, in seat order
+}
+
+// Showdown evaluates every active player's seven cards (including the
+// community card, if one was dealt) with poker.FindBestHand and returns
+// the winners. It requires seventh street to have been dealt.
+func (g *Game) Showdown() (*ShowdownResult, error) {
+	if g.Street != SeventhStreet {
+		return nil, fmt.Errorf("showdown requires seventh street, not %v", g.Street)
+	}
+
+	res := &ShowdownResult{Hands: make([]*poker.Hand, len(g.Players))}
+	var best *poker.Hand
+	for _, i := range g.Active() {
+		cards := g.Players[i].Cards()
+		if g.Community != nil {
+			cards = append(cards, *g.Community)
+		}
+		hand := poker.FindBestHand(cards)
+		res.Hands[i] = hand
+
+		switch cmp := poker.CompareHands(hand, best); {
+		case best == nil || cmp > 0:
+			best, res.Winners = hand, []int{i}
+		case cmp == 0:
+			res.Winners = append(res.Winners, i)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,378 @@
+package stud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Zabooya/poker-hand-evaluation/pkg/poker"
+)
+
+// stackDeck builds a deck that deals each seat the given cards, listed in
+// the order that seat receives them: two down, four up, then one down.
+func stackDeck(t *testing.T, seats ...[]string) *poker.Deck {
+	t.Helper()
+	var cards []poker.Card
+	for i := 0; i < len(seats[0]); i++ {
+		for _, seat := range seats {
+			card, err := poker.ParseCard(seat[i])
+			if err != nil {
+				t.Fatalf("ParseCard(%q): %v", seat[i], err)
+			}
+			cards = append(cards, card)
+		}
+	}
+	return &poker.Deck{Cards: cards}
+}
+
+// dealTo deals streets until the game reaches the given street.
+func dealTo(t *testing.T, g *Game, street Street) {
+	t.Helper()
+	for g.Street < street {
+		if err := g.DealStreet(); err != nil {
+			t.Fatalf("DealStreet on %v: %v", g.Street, err)
+		}
+	}
+}
+
+// TestNewGame verifies player count and deck validation.
+func TestNewGame(t *testing.T) {
+	tests := []struct {
+		name    string
+		deck    *poker.Deck
+		players int
+		wantErr bool
+	}{
+		{"two players", poker.NewDeck(), 2, false},
+		{"eight players", poker.NewDeck(), 8, false},
+		{"one player", poker.NewDeck(), 1, true},
+		{"nine players", poker.NewDeck(), 9, true},
+		{"nil deck", nil, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGame(tt.deck, tt.players)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewGame error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(g.Players) != tt.players {
+				t.Errorf("len(Players) = %d, want %d", len(g.Players), tt.players)
+			}
+		})
+	}
+}
+
+// TestDealStreet verifies the down and up card counts on each street.
+func TestDealStreet(t *testing.T) {
+	g, err := NewGame(poker.NewDeck(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		street   Street
+		down, up int
+	}{
+		{ThirdStreet, 2, 1},
+		{FourthStreet, 2, 2},
+		{FifthStreet, 2, 3},
+		{SixthStreet, 2, 4},
+		{SeventhStreet, 3, 4},
+	}
+	for _, w := range want {
+		if err := g.DealStreet(); err != nil {
+			t.Fatalf("DealStreet: %v", err)
+		}
+		if g.Street != w.street {
+			t.Fatalf("Street = %v, want %v", g.Street, w.street)
+		}
+		for seat, p := range g.Players {
+			if len(p.Down) != w.down || len(p.Up) != w.up {
+				t.Errorf("%v seat %d: %d down, %d up, want %d down, %d up",
+					w.street, seat, len(p.Down), len(p.Up), w.down, w.up)
+			}
+		}
+	}
+
+	if got := len(g.Deck.Cards); got != 52-3*7 {
+		t.Errorf("deck has %d cards left, want %d", got, 52-3*7)
+	}
+	if g.Community != nil {
+		t.Errorf("Community = %v, want nil", g.Community)
+	}
+	if err := g.DealStreet(); err == nil {
+		t.Error("dealing past seventh street should fail")
+	}
+}
+
+// TestDealStreetOrder verifies that cards go round the table one at a time.
+func TestDealStreetOrder(t *testing.T) {
+	deck := stackDeck(t,
+		[]string{"Ah", "Kh", "Qh", "Jh", "Th", "9h", "8h"},
+		[]string{"As", "Ks", "Qs", "Js", "Ts", "9s", "8s"},
+	)
+	g, err := NewGame(deck, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dealTo(t, g, SeventhStreet)
+
+	for seat, suit := range []string{"h", "s"} {
+		p := g.Players[seat]
+		got := append(p.Down[:2:2], p.Up...)
+		got = append(got, p.Down[2])
+		var want []poker.Card
+		for _, rank := range []string{"A", "K", "Q", "J", "T", "9", "8"} {
+			card, _ := poker.ParseCard(rank + suit)
+			want = append(want, card)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("seat %d dealt %v, want %v", seat, got, want)
+		}
+	}
+}
+
+// TestDealStreetCommunityCard verifies that a full table shares one river
+// card once the deck runs short.
+func TestDealStreetCommunityCard(t *testing.T) {
+	g, err := NewGame(poker.NewDeck(), MaxPlayers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dealTo(t, g, SeventhStreet)
+
+	if g.Community == nil {
+		t.Fatal("Community = nil, want a shared card")
+	}
+	for seat, p := range g.Players {
+		if len(p.Down) != 2 || len(p.Up) != 4 {
+			t.Errorf("seat %d: %d down, %d up, want 2 down, 4 up", seat, len(p.Down), len(p.Up))
+		}
+	}
+
+	res, err := g.Showdown()
+	if err != nil {
+		t.Fatalf("Showdown: %v", err)
+	}
+	for seat, hand := range res.Hands {
+		if hand == nil {
+			t.Errorf("seat %d has no hand", seat)
+		}
+	}
+}
+
+// TestFold verifies that folded seats are skipped by later streets.
+func TestFold(t *testing.T) {
+	g, err := NewGame(poker.NewDeck(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dealTo(t, g, ThirdStreet)
+
+	if err := g.Fold(1); err != nil {
+		t.Fatalf("Fold(1): %v", err)
+	}
+	if err := g.Fold(1); err == nil {
+		t.Error("folding twice should fail")
+	}
+	if err := g.Fold(3); err == nil {
+		t.Error("folding a missing seat should fail")
+	}
+
+	dealTo(t, g, FourthStreet)
+	if got := len(g.Players[1].Up); got != 1 {
+		t.Errorf("folded seat has %d up cards, want 1", got)
+	}
+	if got := g.Active(); !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Errorf("Active() = %v, want [0 2]", got)
+	}
+}
+
+// TestBringIn verifies lowest up card selection, aces high, with suits
+// breaking ties in clubs, diamonds, hearts, spades order.
+func TestBringIn(t *testing.T) {
+	tests := []struct {
+		name string
+		up   []string
+		want int
+	}{
+		{"lowest rank", []string{"9h", "4d", "Kc"}, 1},
+		{"aces are high", []string{"Ac", "3s", "Kd"}, 1},
+		{"clubs lowest", []string{"2s", "2h", "2c"}, 2},
+		{"diamonds below hearts", []string{"5h", "5d", "9c"}, 1},
+		{"hearts below spades", []string{"Ts", "Th", "Js"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seats := make([][]string, len(tt.up))
+			filler := []string{"Ah", "Ad", "As", "Kh", "Ks", "Qh"}
+			for i, up := range tt.up {
+				seats[i] = []string{filler[2*i], filler[2*i+1], up}
+			}
+			g, err := NewGame(stackDeck(t, seats...), len(seats))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dealTo(t, g, ThirdStreet)
+
+			got, err := g.BringIn()
+			if err != nil {
+				t.Fatalf("BringIn: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("BringIn() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFirstToAct verifies that the best visible hand acts first after
+// third street, ignoring straights and flushes.
+func TestFirstToAct(t *testing.T) {
+	tests := []struct {
+		name   string
+		seats  [][]string // Up cards per seat on the given street
+		street Street
+		want   int
+	}{
+		{"pair beats ace high", [][]string{{"Ah", "Kd"}, {"4c", "4s"}}, FourthStreet, 1},
+		{"higher kicker", [][]string{{"Ah", "Qd"}, {"As", "Kc"}}, FourthStreet, 1},
+		{"tie goes to lowest seat", [][]string{{"Ah", "Kd"}, {"As", "Kc"}}, FourthStreet, 0},
+		{"trips beat two pair", [][]string{{"9h", "9d", "5c", "5s"}, {"3c", "3d", "3h", "2s"}}, SixthStreet, 1},
+		{"four to a straight flush is only high card", [][]string{{"5h", "6h", "7h", "8h"}, {"Ks", "2c", "3d", "4s"}}, SixthStreet, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filler := []string{"2h", "2d", "Jc", "Js"}
+			seats := make([][]string, len(tt.seats))
+			for i, up := range tt.seats {
+				seats[i] = append([]string{filler[2*i], filler[2*i+1]}, up...)
+			}
+			g, err := NewGame(stackDeck(t, seats...), len(seats))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dealTo(t, g, tt.street)
+
+			got, err := g.FirstToAct()
+			if err != nil {
+				t.Fatalf("FirstToAct: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FirstToAct() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	g, _ := NewGame(poker.NewDeck(), 2)
+	if _, err := g.FirstToAct(); err == nil {
+		t.Error("FirstToAct before dealing should fail")
+	}
+}
+
+// TestVisibleHand verifies ranking of up cards and nil for seats without
+// up cards or outside the table.
+func TestVisibleHand(t *testing.T) {
+	g, err := NewGame(stackDeck(t, []string{"2h", "2d", "Kc", "Ks"}, []string{"Jc", "Js", "4c", "9d"}), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hand := g.VisibleHand(0); hand != nil {
+		t.Errorf("VisibleHand(0) before dealing = %v, want nil", hand)
+	}
+	dealTo(t, g, FourthStreet)
+
+	tests := []struct {
+		seat int
+		want poker.HandCategory
+	}{
+		{0, poker.OnePair},
+		{1, poker.HighCard},
+	}
+	for _, tt := range tests {
+		if hand := g.VisibleHand(tt.seat); hand == nil || hand.Category != tt.want {
+			t.Errorf("VisibleHand(%d) = %v, want %v", tt.seat, hand, tt.want)
+		}
+	}
+	for _, seat := range []int{-1, 2} {
+		if hand := g.VisibleHand(seat); hand != nil {
+			t.Errorf("VisibleHand(%d) = %v, want nil", seat, hand)
+		}
+	}
+}
+
+// TestShowdown verifies evaluation of each seat's seven cards.
+func TestShowdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		seats    [][]string
+		fold     []int
+		winners  []int
+		category poker.HandCategory
+	}{
+		{
+			"flush beats straight",
+			[][]string{
+				{"Ah", "Kd", "Qh", "Jh", "Ts", "2h", "5h"},
+				{"9c", "8c", "7d", "6s", "5d", "Kc", "2d"},
+			},
+			nil, []int{0}, poker.Flush,
+		},
+		{
+			"split pot",
+			[][]string{
+				{"Ah", "Kd", "Qc", "Js", "Th", "2c", "3d"},
+				{"As", "Kc", "Qd", "Jh", "Ts", "4c", "5d"},
+			},
+			nil, []int{0, 1}, poker.Straight,
+		},
+		{
+			"folded seat cannot win",
+			[][]string{
+				{"Ah", "Ad", "Ac", "As", "Kh", "Kd", "Kc"},
+				{"2c", "3d", "7h", "8s", "9c", "Jd", "4h"},
+				{"2d", "3c", "7s", "8h", "9d", "Jc", "5h"},
+			},
+			[]int{0}, []int{2}, poker.HighCard,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGame(stackDeck(t, tt.seats...), len(tt.seats))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dealTo(t, g, SeventhStreet)
+			for _, seat := range tt.fold {
+				if err := g.Fold(seat); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			res, err := g.Showdown()
+			if err != nil {
+				t.Fatalf("Showdown: %v", err)
+			}
+			if !reflect.DeepEqual(res.Winners, tt.winners) {
+				t.Errorf("Winners = %v, want %v", res.Winners, tt.winners)
+			}
+			if got := res.Hands[tt.winners[0]].Category; got != tt.category {
+				t.Errorf("winning Category = %v, want %v", got, tt.category)
+			}
+			for _, seat := range tt.fold {
+				if res.Hands[seat] != nil {
+					t.Errorf("folded seat %d has hand %v", seat, res.Hands[seat])
+				}
+			}
+		})
+	}
+
+	g, _ := NewGame(poker.NewDeck(), 2)
+	dealTo(t, g, SixthStreet)
+	if _, err := g.Showdown(); err == nil {
+		t.Error("Showdown before seventh street should fail")
+	}
+}